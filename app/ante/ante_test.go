@@ -20,9 +20,11 @@ import (
 
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/app/ante"
@@ -1377,3 +1379,116 @@ func expectedGasCostByKeys(pubkeys []cryptotypes.PubKey) uint64 {
 	}
 	return cost
 }
+
+func (suite AnteTestSuite) TestAnteHandlerWithFeeGranter() {
+	addr, privKey := tests.NewAddrKey()
+	granter := tests.GenerateAddress()
+	to := tests.GenerateAddress()
+	fee := big.NewInt(200 * 100000)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		granter    common.Address
+		accessList *types.AccessList
+		expPass    bool
+	}{
+		{
+			"fail - sender without balance",
+			func() {},
+			common.Address{},
+			nil,
+			false,
+		},
+		{
+			"fail - no fee allowance",
+			func() {},
+			granter,
+			&types.AccessList{{Address: granter}},
+			false,
+		},
+		{
+			"fail - granter attached to a tx not committing to it",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter.Bytes(), addr.Bytes(), &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			granter,
+			nil,
+			false,
+		},
+		{
+			"fail - granter attached to a tx committing to another one",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter.Bytes(), addr.Bytes(), &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			granter,
+			&types.AccessList{{Address: to}},
+			false,
+		},
+		{
+			"fail - fee allowance lower than the fees",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter.Bytes(), addr.Bytes(), &feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntFromBigInt(fee).SubRaw(1))),
+				})
+				suite.Require().NoError(err)
+			},
+			granter,
+			&types.AccessList{{Address: granter}},
+			false,
+		},
+		{
+			"success - fees paid by the granter",
+			func() {
+				err := suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, granter.Bytes(), addr.Bytes(), &feegrant.BasicAllowance{})
+				suite.Require().NoError(err)
+			},
+			granter,
+			&types.AccessList{{Address: granter}},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.enableFeemarket = false
+			suite.SetupTest() // reset
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+			suite.Require().NoError(acc.SetSequence(1))
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+			suite.app.AccountKeeper.SetAccount(suite.ctx, suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, granter.Bytes()))
+			suite.app.EvmKeeper.SetBalance(suite.ctx, granter, big.NewInt(10000000000))
+			suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(100))
+
+			tc.malleate()
+
+			ethTx := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 1, &to, big.NewInt(0), 100000, big.NewInt(200), nil, nil, nil, tc.accessList)
+			ethTx.From = addr.Hex()
+
+			// the fee granter is set by whoever wraps the signed eth tx
+			txBuilder := suite.CreateTestTxBuilder(ethTx, privKey, 1, false)
+			if tc.granter != (common.Address{}) {
+				txBuilder.SetFeeGranter(tc.granter.Bytes())
+			}
+
+			_, err := suite.anteHandler(suite.ctx, txBuilder.GetTx(), false)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Equal(big.NewInt(10000000000), suite.app.EvmKeeper.GetBalance(suite.ctx, granter))
+				return
+			}
+			suite.Require().NoError(err)
+
+			suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, addr).Sign())
+			suite.Require().Equal(new(big.Int).Sub(big.NewInt(10000000000), fee), suite.app.EvmKeeper.GetBalance(suite.ctx, granter))
+
+			payer, found := suite.app.EvmKeeper.GetTxFeePayerTransient(suite.ctx, ethTx.AsTransaction().Hash())
+			suite.Require().True(found)
+			suite.Require().Equal(granter, payer)
+		})
+	}
+}
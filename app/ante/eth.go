@@ -24,6 +24,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	ethermint "github.com/evmos/ethermint/types"
 	"github.com/evmos/ethermint/x/evm/keeper"
@@ -64,6 +65,13 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 		return next(ctx, tx, simulate)
 	}
 
+	// the fees can be paid in an alternative fee denom or by a fee granter, in which case the balance
+	// only has to cover the value
+	checkValueOnly := len(avd.evmKeeper.GetParams(ctx).FeeDenoms) > 0
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.FeeGranter() != nil {
+		checkValueOnly = true
+	}
+
 	for i, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
@@ -94,7 +102,13 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
-		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
+		balance := sdkmath.NewIntFromBigInt(acct.Balance)
+		if checkValueOnly {
+			err = keeper.CheckSenderValue(balance, txData)
+		} else {
+			err = keeper.CheckSenderBalance(balance, txData)
+		}
+		if err != nil {
 			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
 		}
 	}
@@ -104,17 +118,20 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 // EthGasConsumeDecorator validates enough intrinsic gas for the transaction and
 // gas consumption.
 type EthGasConsumeDecorator struct {
-	evmKeeper    EVMKeeper
-	maxGasWanted uint64
+	evmKeeper      EVMKeeper
+	feegrantKeeper authante.FeegrantKeeper
+	maxGasWanted   uint64
 }
 
 // NewEthGasConsumeDecorator creates a new EthGasConsumeDecorator
 func NewEthGasConsumeDecorator(
 	evmKeeper EVMKeeper,
+	feegrantKeeper authante.FeegrantKeeper,
 	maxGasWanted uint64,
 ) EthGasConsumeDecorator {
 	return EthGasConsumeDecorator{
 		evmKeeper,
+		feegrantKeeper,
 		maxGasWanted,
 	}
 }
@@ -162,6 +179,24 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	minPriority := int64(math.MaxInt64)
 	baseFee := egcd.evmKeeper.GetBaseFee(ctx, ethCfg)
 
+	// the fees are paid by the fee granter if set, using the allowance granted to each sender. As the
+	// fee granter isn't covered by the eth signature, each sender must commit to it by including its
+	// address in the signed access list, so that a relayer can't spend the sender's allowance.
+	var feeGranter sdk.AccAddress
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		feeGranter = feeTx.FeeGranter()
+	}
+	if feeGranter != nil && egcd.feegrantKeeper == nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	// the gas quotas are only enforced when delivering the tx, as the gas used in the block is unknown
+	// to the mempool
+	var quota *gasQuota
+	if !ctx.IsCheckTx() && !simulate {
+		quota = newGasQuota(evmParams)
+	}
+
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
@@ -173,6 +208,15 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
 		}
 
+		if quota != nil {
+			// the message exceeding a quota isn't rejected by the ante handler, that would discard its fees,
+			// but failed without being executed after paying them, so that the quotas can't be spammed for free
+			if err := quota.consume(ctx, egcd.evmKeeper, common.HexToAddress(msgEthTx.From), txData.GetTo(), txData.GetGas()); err != nil {
+				ctx.Logger().Debug("eth tx exceeds the block gas quotas", "hash", msgEthTx.Hash, "error", err.Error())
+				egcd.evmKeeper.SetTxGasQuotaExceededTransient(ctx, common.HexToHash(msgEthTx.Hash))
+			}
+		}
+
 		if ctx.IsCheckTx() && egcd.maxGasWanted != 0 {
 			// We can't trust the tx gas limit, because we'll refund the unused gas.
 			if txData.GetGas() > egcd.maxGasWanted {
@@ -191,15 +235,48 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
 		}
 
-		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.HexToAddress(msgEthTx.From))
+		from := common.HexToAddress(msgEthTx.From)
+		payer, value := from, txData.GetValue()
+		if feeGranter != nil {
+			// the value is still paid by the sender
+			payer, value = common.BytesToAddress(feeGranter), nil
+
+			if !accessListContains(txData.GetAccessList(), payer) {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrUnauthorized,
+					"fee granter %s is not in the access list signed by %s", payer, from,
+				)
+			}
+		}
+
+		fees = egcd.evmKeeper.GetTxFeeCoins(ctx, fees, value, payer)
+
+		if feeGranter != nil && !fees.IsZero() {
+			err = egcd.feegrantKeeper.UseGrantedFees(ctx, feeGranter, from.Bytes(), fees, []sdk.Msg{msg})
+			if err != nil {
+				return ctx, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, from)
+			}
+		}
+
+		err = egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, payer)
 		if err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 		}
 
+		// the leftover gas is refunded to the fee payer, in the alternative fee denom paid
+		txHash := common.HexToHash(msgEthTx.Hash)
+		if payer != from {
+			egcd.evmKeeper.SetTxFeePayerTransient(ctx, txHash, payer)
+		}
+		if len(fees) == 1 && fees[0].Denom != evmDenom {
+			egcd.evmKeeper.SetTxFeesTransient(ctx, txHash, fees[0])
+		}
+
 		events = append(events,
 			sdk.NewEvent(
 				sdk.EventTypeTx,
 				sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
+				sdk.NewAttribute(sdk.AttributeKeyFeePayer, sdk.AccAddress(payer.Bytes()).String()),
 			),
 		)
 
@@ -242,8 +319,18 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(newCtx, tx, simulate)
 }
 
+// accessListContains returns true if the address is in the access list.
+func accessListContains(accessList ethtypes.AccessList, addr common.Address) bool {
+	for _, tuple := range accessList {
+		if tuple.Address == addr {
+			return true
+		}
+	}
+	return false
+}
+
 // CanTransferDecorator checks if the sender is allowed to transfer funds according to the EVM block
-// context rules.
+// context rules and, on CheckTx, that the value isn't sent from or to a blocked address.
 type CanTransferDecorator struct {
 	evmKeeper EVMKeeper
 }
@@ -278,6 +365,18 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			)
 		}
 
+		// The blocked transfers are kept out of the mempool, with the same rule as the EVM transfer
+		// function. On DeliverTx they are rejected by the EVM transfer function instead, so that the
+		// rejection is recorded in the tx receipt and emits a blocked address event.
+		if ctx.IsCheckTx() && coreMsg.Value().Sign() > 0 {
+			if ctd.evmKeeper.IsBlockedAddress(ctx, coreMsg.From()) {
+				return ctx, errorsmod.Wrapf(evmtypes.ErrBlockedAddress, "sender %s", coreMsg.From())
+			}
+			if to := coreMsg.To(); to != nil && ctd.evmKeeper.IsBlockedAddress(ctx, *to) {
+				return ctx, errorsmod.Wrapf(evmtypes.ErrBlockedAddress, "recipient %s", to)
+			}
+		}
+
 		if evmtypes.IsLondon(ethCfg, ctx.BlockHeight()) {
 			if baseFee == nil {
 				return ctx, errorsmod.Wrap(
@@ -320,6 +419,47 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 	return next(ctx, tx, simulate)
 }
 
+// EthDeployerAllowlistDecorator rejects the contract creation transactions sent by addresses
+// which are not allowed to deploy contracts by the EVM module parameters.
+type EthDeployerAllowlistDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewEthDeployerAllowlistDecorator creates a new EthDeployerAllowlistDecorator instance.
+func NewEthDeployerAllowlistDecorator(evmKeeper EVMKeeper) EthDeployerAllowlistDecorator {
+	return EthDeployerAllowlistDecorator{
+		evmKeeper: evmKeeper,
+	}
+}
+
+// AnteHandle checks the sender of the contract creation transactions against the allowed
+// deployers. The contracts created by calls are checked during the EVM execution.
+func (dad EthDeployerAllowlistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	params := dad.evmKeeper.GetParams(ctx)
+	if len(params.AllowedDeployers) == 0 {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		if msgEthTx.AsTransaction().To() != nil {
+			continue
+		}
+
+		// sender address should be in the tx cache from the previous AnteHandle call
+		from := common.BytesToAddress(msgEthTx.GetFrom())
+		if !params.IsDeployerAllowed(from) {
+			return ctx, errorsmod.Wrapf(evmtypes.ErrCreateNotAllowed, "deployer %s is not allowed", from)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
 // EthIncrementSenderSequenceDecorator increments the sequence of the signers.
 type EthIncrementSenderSequenceDecorator struct {
 	ak evmtypes.AccountKeeper
@@ -375,3 +515,56 @@ func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx s
 
 	return next(ctx, tx, simulate)
 }
+
+// gasQuota accumulates the gas limits of the messages of a tx, to check them against the per block gas
+// quotas of their senders and called contracts.
+type gasQuota struct {
+	maxPerSender   uint64
+	maxPerContract uint64
+	senders        map[common.Address]uint64
+	contracts      map[common.Address]uint64
+}
+
+// newGasQuota returns nil when none of the gas quotas is enabled.
+func newGasQuota(params evmtypes.Params) *gasQuota {
+	if params.MaxBlockGasPerSender == 0 && params.MaxBlockGasPerContract == 0 {
+		return nil
+	}
+	return &gasQuota{
+		maxPerSender:   params.MaxBlockGasPerSender,
+		maxPerContract: params.MaxBlockGasPerContract,
+		senders:        make(map[common.Address]uint64),
+		contracts:      make(map[common.Address]uint64),
+	}
+}
+
+// consume checks that the gas limit of a message fits in the block quotas of its sender and called
+// contract, given the gas already used in the block and the gas limits of the previous messages of the tx.
+// The gas limit is accounted for the next messages even if it exceeds a quota, as the message consumes it.
+func (q *gasQuota) consume(ctx sdk.Context, evmKeeper EVMKeeper, from common.Address, to *common.Address, gasLimit uint64) error {
+	var err error
+
+	if q.maxPerSender > 0 {
+		used := evmKeeper.GetTransientSenderGasUsed(ctx, from) + q.senders[from]
+		if used > q.maxPerSender || gasLimit > q.maxPerSender-used {
+			err = errorsmod.Wrapf(
+				evmtypes.ErrGasQuotaExceeded,
+				"sender %s used %d gas in the block, gas limit %d exceeds the quota %d", from, used, gasLimit, q.maxPerSender,
+			)
+		}
+		q.senders[from] += gasLimit
+	}
+
+	if q.maxPerContract > 0 && to != nil {
+		used := evmKeeper.GetTransientContractGasUsed(ctx, *to) + q.contracts[*to]
+		if err == nil && (used > q.maxPerContract || gasLimit > q.maxPerContract-used) {
+			err = errorsmod.Wrapf(
+				evmtypes.ErrGasQuotaExceeded,
+				"contract %s used %d gas in the block, gas limit %d exceeds the quota %d", to, used, gasLimit, q.maxPerContract,
+			)
+		}
+		q.contracts[*to] += gasLimit
+	}
+
+	return err
+}
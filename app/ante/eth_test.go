@@ -13,6 +13,7 @@ import (
 	"github.com/evmos/ethermint/x/evm/statedb"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -161,7 +162,7 @@ func (suite AnteTestSuite) TestEthNonceVerificationDecorator() {
 }
 
 func (suite AnteTestSuite) TestEthGasConsumeDecorator() {
-	dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, config.DefaultMaxTxGasWanted)
+	dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, suite.app.FeeGrantKeeper, config.DefaultMaxTxGasWanted)
 
 	addr := tests.GenerateAddress()
 
@@ -317,6 +318,71 @@ func (suite AnteTestSuite) TestEthGasConsumeDecorator() {
 	}
 }
 
+func (suite AnteTestSuite) TestEthGasConsumeDecoratorGasQuota() {
+	addr := tests.GenerateAddress()
+	to := tests.GenerateAddress()
+
+	ethCfg := suite.app.EvmKeeper.GetParams(suite.ctx).
+		ChainConfig.EthereumConfig(suite.app.EvmKeeper.ChainID())
+	baseFee := suite.app.EvmKeeper.GetBaseFee(suite.ctx, ethCfg)
+	gasPrice := new(big.Int).Add(baseFee, evmtypes.DefaultPriorityReduction.BigInt())
+
+	txGasLimit := uint64(100000)
+	tx := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 1, &to, big.NewInt(10), txGasLimit, gasPrice, nil, nil, nil, &ethtypes.AccessList{})
+	tx.From = addr.Hex()
+
+	blockGasUsed := uint64(50000)
+	msg := ethtypes.NewMessage(addr, &to, 1, nil, blockGasUsed, nil, nil, nil, nil, nil, false)
+
+	testCases := []struct {
+		name           string
+		checkTx        bool
+		maxPerSender   uint64
+		maxPerContract uint64
+		gasUsed        bool
+		expExceeded    bool
+	}{
+		{"no quota", false, 0, 0, false, false},
+		{"sender quota - gas limit within quota", false, txGasLimit, 0, false, false},
+		{"sender quota - gas limit above quota", false, txGasLimit - 1, 0, false, true},
+		{"sender quota - gas used in the block", false, txGasLimit + blockGasUsed - 1, 0, true, true},
+		{"contract quota - gas limit within quota", false, 0, txGasLimit + blockGasUsed, true, false},
+		{"contract quota - gas used in the block", false, 0, txGasLimit, true, true},
+		{"quotas are not enforced on CheckTx", true, 1, 1, true, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			dec := ante.NewEthGasConsumeDecorator(suite.app.EvmKeeper, suite.app.FeeGrantKeeper, config.DefaultMaxTxGasWanted)
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.MaxBlockGasPerSender = tc.maxPerSender
+			params.MaxBlockGasPerContract = tc.maxPerContract
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			if tc.gasUsed {
+				suite.Require().NoError(suite.app.EvmKeeper.AddTransientQuotaGasUsed(suite.ctx, params, msg, blockGasUsed))
+			}
+
+			vmdb := suite.StateDB()
+			vmdb.AddBalance(addr, new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(txGasLimit)))
+			suite.Require().NoError(vmdb.Commit())
+
+			ctx := suite.ctx.WithIsCheckTx(tc.checkTx).
+				WithGasMeter(sdk.NewInfiniteGasMeter()).
+				WithBlockGasMeter(sdk.NewGasMeter(10000000000000000000))
+			balanceBefore := suite.app.EvmKeeper.GetBalance(ctx, addr)
+
+			// the tx exceeding a quota isn't rejected, it pays its fees and is failed on execution
+			_, err := dec.AnteHandle(ctx, tx, false, NextFn)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expExceeded, suite.app.EvmKeeper.IsTxGasQuotaExceededTransient(ctx, common.HexToHash(tx.Hash)))
+			suite.Require().Equal(-1, suite.app.EvmKeeper.GetBalance(ctx, addr).Cmp(balanceBefore))
+		})
+	}
+}
+
 func (suite AnteTestSuite) TestCanTransferDecorator() {
 	dec := ante.NewCanTransferDecorator(suite.app.EvmKeeper)
 
@@ -352,16 +418,33 @@ func (suite AnteTestSuite) TestCanTransferDecorator() {
 	err := tx.Sign(suite.ethSigner, tests.NewSigner(privKey))
 	suite.Require().NoError(err)
 
+	zeroValueTx := evmtypes.NewTxContract(
+		suite.app.EvmKeeper.ChainID(),
+		1,
+		big.NewInt(0),
+		1000,
+		big.NewInt(150),
+		big.NewInt(200),
+		nil,
+		nil,
+		&ethtypes.AccessList{},
+	)
+	zeroValueTx.From = addr.Hex()
+
+	err = zeroValueTx.Sign(suite.ethSigner, tests.NewSigner(privKey))
+	suite.Require().NoError(err)
+
 	var vmdb *statedb.StateDB
 
 	testCases := []struct {
-		name     string
-		tx       sdk.Tx
-		malleate func()
-		expPass  bool
+		name      string
+		tx        sdk.Tx
+		malleate  func()
+		deliverTx bool
+		expPass   bool
 	}{
-		{"invalid transaction type", &invalidTx{}, func() {}, false},
-		{"AsMessage failed", tx2, func() {}, false},
+		{"invalid transaction type", &invalidTx{}, func() {}, false, false},
+		{"AsMessage failed", tx2, func() {}, false, false},
 		{
 			"evm CanTransfer failed",
 			tx,
@@ -370,6 +453,7 @@ func (suite AnteTestSuite) TestCanTransferDecorator() {
 				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
 			},
 			false,
+			false,
 		},
 		{
 			"success",
@@ -380,6 +464,45 @@ func (suite AnteTestSuite) TestCanTransferDecorator() {
 
 				vmdb.AddBalance(addr, big.NewInt(1000000))
 			},
+			false,
+			true,
+		},
+		{
+			"blocked sender",
+			tx,
+			func() {
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				vmdb.AddBalance(addr, big.NewInt(1000000))
+				suite.app.EvmKeeper.SetBlockedAddress(suite.ctx, addr)
+			},
+			false,
+			false,
+		},
+		{
+			"blocked sender without value",
+			zeroValueTx,
+			func() {
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				suite.app.EvmKeeper.SetBlockedAddress(suite.ctx, addr)
+			},
+			false,
+			true,
+		},
+		{
+			"blocked sender on DeliverTx is left to the EVM",
+			tx,
+			func() {
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				vmdb.AddBalance(addr, big.NewInt(1000000))
+				suite.app.EvmKeeper.SetBlockedAddress(suite.ctx, addr)
+			},
+			true,
 			true,
 		},
 	}
@@ -390,7 +513,7 @@ func (suite AnteTestSuite) TestCanTransferDecorator() {
 			tc.malleate()
 			suite.Require().NoError(vmdb.Commit())
 
-			_, err := dec.AnteHandle(suite.ctx.WithIsCheckTx(true), tc.tx, false, NextFn)
+			_, err := dec.AnteHandle(suite.ctx.WithIsCheckTx(!tc.deliverTx), tc.tx, false, NextFn)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
@@ -401,6 +524,50 @@ func (suite AnteTestSuite) TestCanTransferDecorator() {
 	}
 }
 
+func (suite AnteTestSuite) TestEthDeployerAllowlistDecorator() {
+	dec := ante.NewEthDeployerAllowlistDecorator(suite.app.EvmKeeper)
+	addr, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	contract := evmtypes.NewTxContract(suite.app.EvmKeeper.ChainID(), 0, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
+	contract.From = addr.Hex()
+	err := contract.Sign(suite.ethSigner, tests.NewSigner(privKey))
+	suite.Require().NoError(err)
+
+	tx := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 0, &to, big.NewInt(10), 1000, big.NewInt(1), nil, nil, nil, nil)
+	tx.From = addr.Hex()
+	err = tx.Sign(suite.ethSigner, tests.NewSigner(privKey))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		tx        sdk.Tx
+		deployers []string
+		expPass   bool
+	}{
+		{"no allowlist", contract, nil, true},
+		{"invalid transaction type", &invalidTx{}, []string{to.Hex()}, false},
+		{"deployer not allowed", contract, []string{to.Hex()}, false},
+		{"call not restricted", tx, []string{to.Hex()}, true},
+		{"deployer allowed", contract, []string{to.Hex(), addr.Hex()}, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.AllowedDeployers = tc.deployers
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			_, err := dec.AnteHandle(suite.ctx, tc.tx, false, NextFn)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite AnteTestSuite) TestEthIncrementSenderSequenceDecorator() {
 	dec := ante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
 	addr, privKey := tests.NewAddrKey()
@@ -37,6 +37,8 @@ import (
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it fallbacks to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
+// - when the fees are not paid in the EVM denom, the first alternative fee denom of the tx is
+// converted to the EVM denom.
 func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) authante.TxFeeChecker {
 	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
 		feeTx, ok := tx.(sdk.FeeTx)
@@ -76,6 +78,20 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) authante.TxFeeChecker {
 		feeCoins := feeTx.GetFee()
 		fee := feeCoins.AmountOfNoDenomValidation(denom)
 
+		// the fees can be paid in an alternative fee denom, converted to the EVM denom
+		feeDenom, rate := denom, sdk.OneDec()
+		if fee.IsZero() {
+			for _, coin := range feeCoins {
+				coinRate, err := k.GetFeeDenomConversionRate(ctx, params, coin.Denom)
+				if err != nil {
+					continue
+				}
+				feeDenom, rate = coin.Denom, coinRate
+				fee = sdk.NewDecFromInt(coin.Amount).Quo(rate).TruncateInt()
+				break
+			}
+		}
+
 		feeCap := fee.Quo(sdkmath.NewIntFromUint64(gas))
 		baseFeeInt := sdkmath.NewIntFromBigInt(baseFee)
 
@@ -86,11 +102,16 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) authante.TxFeeChecker {
 		// calculate the effective gas price using the EIP-1559 logic.
 		effectivePrice := sdkmath.NewIntFromBigInt(types.EffectiveGasPrice(baseFeeInt.BigInt(), feeCap.BigInt(), maxPriorityPrice.BigInt()))
 
+		effectiveFeeAmount := effectivePrice.Mul(sdkmath.NewIntFromUint64(gas))
+		if feeDenom != denom {
+			effectiveFeeAmount = sdk.NewDecFromInt(effectiveFeeAmount).Mul(rate).Ceil().TruncateInt()
+		}
+
 		// NOTE: create a new coins slice without having to validate the denom
 		effectiveFee := sdk.Coins{
 			{
-				Denom:  denom,
-				Amount: effectivePrice.Mul(sdkmath.NewIntFromUint64(gas)),
+				Denom:  feeDenom,
+				Amount: effectiveFeeAmount,
 			},
 		}
 
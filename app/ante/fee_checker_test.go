@@ -24,6 +24,7 @@ var _ DynamicFeeEVMKeeper = MockEVMKeeper{}
 type MockEVMKeeper struct {
 	BaseFee        *big.Int
 	EnableLondonHF bool
+	FeeDenoms      []evmtypes.FeeDenom
 }
 
 func (m MockEVMKeeper) GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int {
@@ -34,7 +35,17 @@ func (m MockEVMKeeper) GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *
 }
 
 func (m MockEVMKeeper) GetParams(ctx sdk.Context) evmtypes.Params {
-	return evmtypes.DefaultParams()
+	params := evmtypes.DefaultParams()
+	params.FeeDenoms = m.FeeDenoms
+	return params
+}
+
+func (m MockEVMKeeper) GetFeeDenomConversionRate(ctx sdk.Context, params evmtypes.Params, denom string) (sdk.Dec, error) {
+	feeDenom, found := params.GetFeeDenom(denom)
+	if !found {
+		return sdk.Dec{}, evmtypes.ErrInvalidFeeDenom
+	}
+	return feeDenom.ConversionRate, nil
 }
 
 func (m MockEVMKeeper) ChainID() *big.Int {
@@ -203,6 +214,40 @@ func TestSDKTxFeeChecker(t *testing.T) {
 			5,
 			true,
 		},
+		{
+			"success, dynamic fee in alternative fee denom",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+				FeeDenoms: []evmtypes.FeeDenom{{Denom: "uusdc", ConversionRate: sdk.NewDecWithPrec(5, 1)}},
+			},
+			func() sdk.Tx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(10))))
+				return txBuilder.GetTx()
+			},
+			"10uusdc",
+			0,
+			true,
+		},
+		{
+			"fail, dynamic fee in alternative fee denom lower than base fee",
+			deliverTxCtx,
+			MockEVMKeeper{
+				EnableLondonHF: true, BaseFee: big.NewInt(10),
+				FeeDenoms: []evmtypes.FeeDenom{{Denom: "uusdc", ConversionRate: sdk.NewDecWithPrec(5, 1)}},
+			},
+			func() sdk.Tx {
+				txBuilder := encodingConfig.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(1)
+				txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewCoin("uusdc", sdk.NewInt(4))))
+				return txBuilder.GetTx()
+			},
+			"",
+			0,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
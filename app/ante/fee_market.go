@@ -19,7 +19,10 @@ import (
 	"math/big"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
 // GasWantedDecorator keeps track of the gasWanted amount on the current block in transient store
@@ -62,7 +65,25 @@ func (gwd GasWantedDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		if _, err := gwd.feeMarketKeeper.AddTransientGasWanted(ctx, gasWanted); err != nil {
 			return ctx, errorsmod.Wrapf(err, "failed to add gas wanted to transient store")
 		}
+
+		// Record the base fee portion of the fees of the cosmos txs paid in the EVM denomination.
+		// The one of the Ethereum txs depends on the gas used and is recorded on their execution.
+		if baseFee := gwd.evmKeeper.GetBaseFee(ctx, ethCfg); baseFee != nil && !isEthTx(tx) {
+			baseFees := sdkmath.NewIntFromBigInt(baseFee).Mul(sdkmath.NewIntFromUint64(gasWanted))
+			paid := feeTx.GetFee().AmountOf(evmParams.EvmDenom)
+			gwd.feeMarketKeeper.AddTransientBaseFees(ctx, sdk.NewCoins(sdk.NewCoin(evmParams.EvmDenom, sdkmath.MinInt(paid, baseFees))))
+		}
 	}
 
 	return next(ctx, tx, simulate)
 }
+
+// isEthTx returns true if the tx wraps Ethereum transactions.
+func isEthTx(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return false
+	}
+	_, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	return ok
+}
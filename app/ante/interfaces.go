@@ -37,6 +37,7 @@ type DynamicFeeEVMKeeper interface {
 	ChainID() *big.Int
 	GetParams(ctx sdk.Context) evmtypes.Params
 	GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int
+	GetFeeDenomConversionRate(ctx sdk.Context, params evmtypes.Params, denom string) (sdk.Dec, error)
 }
 
 // EVMKeeper defines the expected keeper interface used on the Eth AnteHandler
@@ -46,10 +47,17 @@ type EVMKeeper interface {
 
 	NewEVM(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) evm.EVM
 	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	GetTxFeeCoins(ctx sdk.Context, fees sdk.Coins, value *big.Int, from common.Address) sdk.Coins
+	SetTxFeesTransient(ctx sdk.Context, txHash common.Hash, fees sdk.Coin)
+	SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, payer common.Address)
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
+	GetTransientSenderGasUsed(ctx sdk.Context, sender common.Address) uint64
+	GetTransientContractGasUsed(ctx sdk.Context, contract common.Address) uint64
+	SetTxGasQuotaExceededTransient(ctx sdk.Context, txHash common.Hash)
 	GetTxIndexTransient(ctx sdk.Context) uint64
 	GetParams(ctx sdk.Context) evmtypes.Params
+	IsBlockedAddress(ctx sdk.Context, addr common.Address) bool
 }
 
 type protoTxProvider interface {
@@ -60,5 +68,6 @@ type protoTxProvider interface {
 type FeeMarketKeeper interface {
 	GetParams(ctx sdk.Context) (params feemarkettypes.Params)
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
+	AddTransientBaseFees(ctx sdk.Context, fees sdk.Coins)
 	GetBaseFeeEnabled(ctx sdk.Context) bool
 }
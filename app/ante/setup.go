@@ -92,6 +92,41 @@ func (eeed EthEmitEventDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulat
 	return next(ctx, tx, simulate)
 }
 
+// checkTxExpiry rejects the eth txs including an expiry extension option once the block height or
+// time is past their expiry.
+func checkTxExpiry(ctx sdk.Context, tx sdk.Tx) error {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+
+	expiry, err := evmtypes.GetTxExpiry(extTx.GetExtensionOptions())
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid tx expiry")
+	}
+
+	if expiry == nil {
+		return nil
+	}
+
+	// CheckTx and ReCheckTx run on the last committed block, while the tx can only be included in the
+	// next one
+	height := ctx.BlockHeight()
+	if ctx.IsCheckTx() {
+		height++
+	}
+
+	if expiry.IsExpired(height, ctx.BlockTime()) {
+		return errorsmod.Wrapf(
+			evmtypes.ErrTxExpired,
+			"max block height %d, max timestamp %d, block height %d, block time %d",
+			expiry.MaxBlockHeight, expiry.MaxTimestamp, height, ctx.BlockTime().Unix(),
+		)
+	}
+
+	return nil
+}
+
 // EthValidateBasicDecorator is adapted from ValidateBasicDecorator from cosmos-sdk, it ignores ErrNoSignatures
 type EthValidateBasicDecorator struct {
 	evmKeeper EVMKeeper
@@ -106,6 +141,11 @@ func NewEthValidateBasicDecorator(ek EVMKeeper) EthValidateBasicDecorator {
 
 // AnteHandle handles basic validation of tx
 func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	// the expiry is checked on recheck tx too, to evict the expired txs from the mempool
+	if err := checkTxExpiry(ctx, tx); err != nil {
+		return ctx, err
+	}
+
 	// no need to validate basic on recheck tx, call next antehandler
 	if ctx.IsReCheckTx() {
 		return next(ctx, tx, simulate)
@@ -131,8 +171,10 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 			"for eth tx body Memo TimeoutHeight NonCriticalExtensionOptions should be empty")
 	}
 
-	if len(body.ExtensionOptions) != 1 {
-		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be 1")
+	// the ExtensionOptionsEthereumTx option can be followed by an ExtensionOptionsEthereumTxExpiry option,
+	// validated by checkTxExpiry
+	if len(body.ExtensionOptions) != 1 && len(body.ExtensionOptions) != 2 {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be 1, or 2 with an expiry")
 	}
 
 	authInfo := protoTx.AuthInfo
@@ -140,8 +182,9 @@ func (vbd EthValidateBasicDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo SignerInfos should be empty")
 	}
 
-	if authInfo.Fee.Payer != "" || authInfo.Fee.Granter != "" {
-		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer and granter should be empty")
+	// the fees can be paid by a fee granter, but not by a payer that doesn't sign the tx
+	if authInfo.Fee.Payer != "" {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx AuthInfo Fee payer should be empty")
 	}
 
 	sigs := protoTx.Signatures
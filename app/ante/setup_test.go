@@ -2,10 +2,16 @@ package ante_test
 
 import (
 	"math/big"
+	"time"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/evmos/ethermint/app/ante"
+	"github.com/evmos/ethermint/tests"
+	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -40,3 +46,134 @@ func (suite AnteTestSuite) TestEthSetupContextDecorator() {
 		})
 	}
 }
+
+func (suite AnteTestSuite) TestEthValidateBasicDecoratorTxExpiry() {
+	dec := ante.NewEthValidateBasicDecorator(suite.app.EvmKeeper)
+	addr, privKey := tests.NewAddrKey()
+	to := tests.GenerateAddress()
+
+	height := int64(10)
+	blockTime := time.Unix(1000, 0)
+	ctx := suite.ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+
+	buildTx := func(expiry *evmtypes.ExtensionOptionsEthereumTxExpiry) sdk.Tx {
+		msg := evmtypes.NewTx(suite.app.EvmKeeper.ChainID(), 1, &to, big.NewInt(10), 100000, big.NewInt(150), nil, nil, nil, nil)
+		msg.From = addr.Hex()
+		suite.Require().NoError(msg.Sign(suite.ethSigner, tests.NewSigner(privKey)))
+
+		tx, err := msg.BuildTxWithExpiry(suite.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom, expiry)
+		suite.Require().NoError(err)
+		return tx
+	}
+
+	testCases := []struct {
+		name    string
+		txFn    func() sdk.Tx
+		checkTx bool
+		reCheck bool
+		expErr  error
+	}{
+		{
+			"success - no expiry",
+			func() sdk.Tx { return buildTx(nil) },
+			false,
+			false,
+			nil,
+		},
+		{
+			"success - max block height not reached",
+			func() sdk.Tx {
+				return buildTx(&evmtypes.ExtensionOptionsEthereumTxExpiry{MaxBlockHeight: uint64(height)})
+			},
+			false,
+			false,
+			nil,
+		},
+		{
+			"success - max timestamp not reached",
+			func() sdk.Tx {
+				return buildTx(&evmtypes.ExtensionOptionsEthereumTxExpiry{MaxTimestamp: uint64(blockTime.Unix())})
+			},
+			false,
+			false,
+			nil,
+		},
+		{
+			"fail - max block height passed",
+			func() sdk.Tx {
+				return buildTx(&evmtypes.ExtensionOptionsEthereumTxExpiry{MaxBlockHeight: uint64(height - 1)})
+			},
+			false,
+			false,
+			evmtypes.ErrTxExpired,
+		},
+		{
+			"fail - max timestamp passed",
+			func() sdk.Tx {
+				return buildTx(&evmtypes.ExtensionOptionsEthereumTxExpiry{
+					MaxBlockHeight: uint64(height),
+					MaxTimestamp:   uint64(blockTime.Unix() - 1),
+				})
+			},
+			false,
+			false,
+			evmtypes.ErrTxExpired,
+		},
+		{
+			"fail - max block height reached on check tx, as the tx can only be included in the next block",
+			func() sdk.Tx {
+				return buildTx(&evmtypes.ExtensionOptionsEthereumTxExpiry{MaxBlockHeight: uint64(height)})
+			},
+			true,
+			false,
+			evmtypes.ErrTxExpired,
+		},
+		{
+			"success - max block height of the next block on check tx",
+			func() sdk.Tx {
+				return buildTx(&evmtypes.ExtensionOptionsEthereumTxExpiry{MaxBlockHeight: uint64(height + 1)})
+			},
+			true,
+			false,
+			nil,
+		},
+		{
+			"fail - expired tx evicted on recheck",
+			func() sdk.Tx {
+				return buildTx(&evmtypes.ExtensionOptionsEthereumTxExpiry{MaxBlockHeight: uint64(height - 1)})
+			},
+			false,
+			true,
+			evmtypes.ErrTxExpired,
+		},
+		{
+			"fail - invalid second extension option",
+			func() sdk.Tx {
+				tx := buildTx(nil)
+				builder, err := suite.clientCtx.TxConfig.WrapTxBuilder(tx)
+				suite.Require().NoError(err)
+
+				option, err := codectypes.NewAnyWithValue(&evmtypes.ExtensionOptionsEthereumTx{})
+				suite.Require().NoError(err)
+				web3Option, err := codectypes.NewAnyWithValue(&ethermint.ExtensionOptionsWeb3Tx{})
+				suite.Require().NoError(err)
+				builder.(authtx.ExtensionOptionsTxBuilder).SetExtensionOptions(option, web3Option)
+				return builder.GetTx()
+			},
+			false,
+			false,
+			errortypes.ErrUnknownExtensionOptions,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := dec.AnteHandle(ctx.WithIsCheckTx(tc.checkTx).WithIsReCheckTx(tc.reCheck), tc.txFn(), false, NextFn)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -16,6 +16,7 @@
 package indexer
 
 import (
+	"bytes"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -67,6 +68,144 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 	batch := kv.db.NewBatch()
 	defer batch.Close()
 
+	for _, entry := range kv.parseBlock(block, txResults) {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, entry.hash, entry.result); err != nil {
+			return errorsmod.Wrapf(err, "IndexBlock %d", height)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
+	}
+	return nil
+}
+
+// ReindexBlock removes the existing entries of the block and indexes it again
+// in a single batch, so it's safe to call on blocks that are already (partially) indexed.
+func (kv *KVIndexer) ReindexBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	height := block.Header.Height
+
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	if _, err := kv.deleteBlocks(batch, height, height+1); err != nil {
+		return errorsmod.Wrapf(err, "ReindexBlock %d", height)
+	}
+	for _, entry := range kv.parseBlock(block, txResults) {
+		if err := saveTxResult(kv.clientCtx.Codec, batch, entry.hash, entry.result); err != nil {
+			return errorsmod.Wrapf(err, "ReindexBlock %d", height)
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return errorsmod.Wrapf(err, "ReindexBlock %d, write batch", height)
+	}
+	return nil
+}
+
+// VerifyBlock checks the indexed entries of the block against the ones parsed from the block results,
+// returns an error describing the first mismatch found.
+func (kv *KVIndexer) VerifyBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) error {
+	height := block.Header.Height
+
+	indexed, err := kv.BlockTxHashes(height)
+	if err != nil {
+		return err
+	}
+	expected := kv.parseBlock(block, txResults)
+	if len(indexed) != len(expected) {
+		return fmt.Errorf("block %d: indexed %d eth txs, expect %d", height, len(indexed), len(expected))
+	}
+	for i, entry := range expected {
+		if indexed[i] != entry.hash {
+			return fmt.Errorf("block %d: eth tx index %d, indexed hash %s, expect %s", height, i, indexed[i].Hex(), entry.hash.Hex())
+		}
+		res, err := kv.GetByTxHash(entry.hash)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(kv.clientCtx.Codec.MustMarshal(res), kv.clientCtx.Codec.MustMarshal(entry.result)) {
+			return fmt.Errorf("block %d: tx result of %s don't match", height, entry.hash.Hex())
+		}
+	}
+	return nil
+}
+
+// BlockTxHashes returns the eth tx hashes indexed for the block, ordered by eth tx index.
+func (kv *KVIndexer) BlockTxHashes(height int64) ([]common.Hash, error) {
+	it, err := kv.db.Iterator(TxIndexKey(height, 0), TxIndexKey(height+1, 0))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "BlockTxHashes %d", height)
+	}
+	defer it.Close()
+
+	var hashes []common.Hash
+	for ; it.Valid(); it.Next() {
+		hashes = append(hashes, common.BytesToHash(it.Value()))
+	}
+	return hashes, it.Error()
+}
+
+// PruneBlocksAbove deletes the entries of all the blocks higher than the height,
+// it's used to recover the indexer db after the chain is rolled back. Returns the number of eth txs deleted.
+func (kv *KVIndexer) PruneBlocksAbove(height int64) (int, error) {
+	batch := kv.db.NewBatch()
+	defer batch.Close()
+
+	deleted, err := kv.deleteBlocks(batch, height+1, -1)
+	if err != nil {
+		return 0, errorsmod.Wrapf(err, "PruneBlocksAbove %d", height)
+	}
+	if err := batch.Write(); err != nil {
+		return 0, errorsmod.Wrapf(err, "PruneBlocksAbove %d, write batch", height)
+	}
+	return deleted, nil
+}
+
+// deleteBlocks adds the deletion of the entries of the blocks in range [start, end) into the batch,
+// end of -1 means no upper bound. Returns the number of eth txs deleted.
+func (kv *KVIndexer) deleteBlocks(batch dbm.Batch, start, end int64) (int, error) {
+	endKey := []byte{KeyPrefixTxIndex + 1}
+	if end >= 0 {
+		endKey = TxIndexKey(end, 0)
+	}
+	it, err := kv.db.Iterator(TxIndexKey(start, 0), endKey)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	var deleted int
+	for ; it.Valid(); it.Next() {
+		height, err := parseBlockNumberFromKey(it.Key())
+		if err != nil {
+			return 0, err
+		}
+		txHash := common.BytesToHash(it.Value())
+		// the tx hash entry could point to another block if the tx was re-included later
+		res, err := kv.GetByTxHash(txHash)
+		if err == nil && res.Height == height {
+			if err := batch.Delete(TxHashKey(txHash)); err != nil {
+				return 0, errorsmod.Wrap(err, "delete tx-hash key")
+			}
+		}
+		if err := batch.Delete(it.Key()); err != nil {
+			return 0, errorsmod.Wrap(err, "delete tx-index key")
+		}
+		deleted++
+	}
+	return deleted, it.Error()
+}
+
+// indexedTx is an eth tx parsed from the block results, ready to be saved into the indexer db.
+type indexedTx struct {
+	hash   common.Hash
+	result *ethermint.TxResult
+}
+
+// parseBlock parses all the eth txs in a block, the failed ones are skipped with an error log.
+func (kv *KVIndexer) parseBlock(block *tmtypes.Block, txResults []*abci.ResponseDeliverTx) []indexedTx {
+	height := block.Header.Height
+
+	var entries []indexedTx
 	// record index of valid eth tx during the iteration
 	var ethTxIndex int32
 	for txIndex, tx := range block.Txs {
@@ -118,21 +257,17 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
+				txResult.FeePayer = parsedTx.FeePayer
 			}
 
 			cumulativeGasUsed += txResult.GasUsed
 			txResult.CumulativeGasUsed = cumulativeGasUsed
 			ethTxIndex++
 
-			if err := saveTxResult(kv.clientCtx.Codec, batch, txHash, &txResult); err != nil {
-				return errorsmod.Wrapf(err, "IndexBlock %d", height)
-			}
+			entries = append(entries, indexedTx{hash: txHash, result: &txResult})
 		}
 	}
-	if err := batch.Write(); err != nil {
-		return errorsmod.Wrapf(err, "IndexBlock %d, write batch", block.Height)
-	}
-	return nil
+	return entries
 }
 
 // LastIndexedBlock returns the latest indexed block number, returns -1 if db is empty
@@ -218,7 +353,8 @@ func isEthTx(tx sdk.Tx) bool {
 		return false
 	}
 	opts := extTx.GetExtensionOptions()
-	if len(opts) != 1 || opts[0].GetTypeUrl() != "/ethermint.evm.v1.ExtensionOptionsEthereumTx" {
+	// the ExtensionOptionsEthereumTx option can be followed by an expiry option
+	if len(opts) == 0 || len(opts) > 2 || opts[0].GetTypeUrl() != "/ethermint.evm.v1.ExtensionOptionsEthereumTx" {
 		return false
 	}
 	return true
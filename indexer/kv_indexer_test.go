@@ -183,6 +183,88 @@ func TestKVIndexer(t *testing.T) {
 	}
 }
 
+func TestKVIndexerRepair(t *testing.T) {
+	priv, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := common.BytesToAddress(priv.PubKey().Address().Bytes())
+	signer := tests.NewSigner(priv)
+	ethSigner := ethtypes.LatestSignerForChainID(nil)
+
+	to := common.BigToAddress(big.NewInt(1))
+	tx := types.NewTx(
+		nil, 0, &to, big.NewInt(1000), 21000, nil, nil, nil, nil, nil,
+	)
+	tx.From = from.Hex()
+	require.NoError(t, tx.Sign(ethSigner, signer))
+	txHash := tx.AsTransaction().Hash()
+
+	encodingConfig := MakeEncodingConfig()
+	clientCtx := client.Context{}.WithTxConfig(encodingConfig.TxConfig).WithCodec(encodingConfig.Codec)
+
+	tmTx, err := tx.BuildTx(clientCtx.TxConfig.NewTxBuilder(), "aphoton")
+	require.NoError(t, err)
+	txBz, err := clientCtx.TxConfig.TxEncoder()(tmTx)
+	require.NoError(t, err)
+
+	block := func(height int64) *tmtypes.Block {
+		return &tmtypes.Block{Header: tmtypes.Header{Height: height}, Data: tmtypes.Data{Txs: []tmtypes.Tx{txBz}}}
+	}
+	blockResult := func(gasUsed int64) []*abci.ResponseDeliverTx {
+		return []*abci.ResponseDeliverTx{
+			{
+				Code:    0,
+				GasUsed: gasUsed,
+				Events: []abci.Event{
+					{Type: types.EventTypeEthereumTx, Attributes: []abci.EventAttribute{
+						{Key: []byte("ethereumTxHash"), Value: []byte(txHash.Hex())},
+						{Key: []byte("txIndex"), Value: []byte("0")},
+						{Key: []byte("amount"), Value: []byte("1000")},
+						{Key: []byte("txGasUsed"), Value: []byte("21000")},
+						{Key: []byte("txHash"), Value: []byte("")},
+						{Key: []byte("recipient"), Value: []byte("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")},
+					}},
+				},
+			},
+		}
+	}
+
+	db := dbm.NewMemDB()
+	idxer := indexer.NewKVIndexer(db, tmlog.NewNopLogger(), clientCtx)
+
+	// gap: nothing indexed yet
+	require.Error(t, idxer.VerifyBlock(block(1), blockResult(21000)))
+
+	// mismatch: indexed with different gas used
+	require.NoError(t, idxer.IndexBlock(block(1), blockResult(30000)))
+	require.Error(t, idxer.VerifyBlock(block(1), blockResult(21000)))
+
+	// re-index fixes the mismatch
+	require.NoError(t, idxer.ReindexBlock(block(1), blockResult(21000)))
+	require.NoError(t, idxer.VerifyBlock(block(1), blockResult(21000)))
+	res, err := idxer.GetByTxHash(txHash)
+	require.NoError(t, err)
+	require.Equal(t, uint64(21000), res.GasUsed)
+
+	// the tx is re-included in a later block, then rolled back
+	require.NoError(t, idxer.IndexBlock(block(3), blockResult(21000)))
+	last, err := idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(3), last)
+
+	deleted, err := idxer.PruneBlocksAbove(2)
+	require.NoError(t, err)
+	require.Equal(t, 1, deleted)
+
+	last, err = idxer.LastIndexedBlock()
+	require.NoError(t, err)
+	require.Equal(t, int64(1), last)
+	hashes, err := idxer.BlockTxHashes(3)
+	require.NoError(t, err)
+	require.Empty(t, hashes)
+	_, err = idxer.GetByTxHash(txHash)
+	require.Error(t, err)
+}
+
 // MakeEncodingConfig creates the EncodingConfig
 func MakeEncodingConfig() params.EncodingConfig {
 	return evmenc.MakeConfig(app.ModuleBasics)
@@ -68,6 +68,10 @@ type APICreator = func(
 // apiCreators defines the JSON-RPC API namespaces.
 var apiCreators map[string]APICreator
 
+// historicalAPICreators defines the JSON-RPC API namespaces served in json-rpc only mode,
+// where there's neither application state nor running tendermint node.
+var historicalAPICreators map[string]APICreator
+
 func init() {
 	apiCreators = map[string]APICreator{
 		EthNamespace: func(ctx *server.Context,
@@ -150,7 +154,7 @@ func init() {
 					Namespace: DebugNamespace,
 					Version:   apiVersion,
 					Service:   debug.NewAPI(ctx, evmBackend),
-					Public:    true,
+					Public:    false,
 				},
 			}
 		},
@@ -171,6 +175,33 @@ func init() {
 			}
 		},
 	}
+
+	historicalAPICreators = map[string]APICreator{
+		EthNamespace: func(ctx *server.Context,
+			clientCtx client.Context,
+			_ *rpcclient.WSClient,
+			allowUnprotectedTxs bool,
+			indexer ethermint.EVMTxIndexer,
+		) []rpc.API {
+			evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+			return []rpc.API{
+				{
+					Namespace: EthNamespace,
+					Version:   apiVersion,
+					Service:   eth.NewHistoricalAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+				{
+					Namespace: EthNamespace,
+					Version:   apiVersion,
+					Service:   filters.NewHistoricalAPI(ctx.Logger, evmBackend),
+					Public:    true,
+				},
+			}
+		},
+		Web3Namespace: apiCreators[Web3Namespace],
+		NetNamespace:  apiCreators[NetNamespace],
+	}
 }
 
 // GetRPCAPIs returns the list of all APIs
@@ -194,6 +225,27 @@ func GetRPCAPIs(ctx *server.Context,
 	return apis
 }
 
+// GetHistoricalRPCAPIs returns the list of APIs served in json-rpc only mode,
+// the namespaces that need application state or a running node are skipped.
+func GetHistoricalRPCAPIs(ctx *server.Context,
+	clientCtx client.Context,
+	allowUnprotectedTxs bool,
+	indexer ethermint.EVMTxIndexer,
+	selectedAPIs []string,
+) []rpc.API {
+	var apis []rpc.API
+
+	for _, ns := range selectedAPIs {
+		if creator, ok := historicalAPICreators[ns]; ok {
+			apis = append(apis, creator(ctx, clientCtx, nil, allowUnprotectedTxs, indexer)...)
+		} else {
+			ctx.Logger.Info("namespace not supported in json-rpc only mode", "namespace", ns)
+		}
+	}
+
+	return apis
+}
+
 // RegisterAPINamespace registers a new API namespace with the API creator.
 // This function fails if the namespace is already registered.
 func RegisterAPINamespace(ns string, creator APICreator) error {
@@ -0,0 +1,110 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package rpc
+
+import (
+	"bytes"
+	"crypto/subtle"
+	"encoding/hex"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/pkg/errors"
+)
+
+// jwtExpiryTimeout is the maximum allowed drift of the JWT issued-at claim, same as geth authrpc.
+const jwtExpiryTimeout = 60 * time.Second
+
+// ReadJWTSecret reads the hex encoded 32 bytes JWT secret from the file at path.
+func ReadJWTSecret(path string) ([]byte, error) {
+	data, err := os.ReadFile(path) // #nosec G304 -- path is set by the node operator
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read JWT secret file %s", path)
+	}
+
+	secret, err := hex.DecodeString(strings.TrimPrefix(string(bytes.TrimSpace(data)), "0x"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid JWT secret in %s", path)
+	}
+
+	if len(secret) != 32 {
+		return nil, errors.Errorf("invalid JWT secret length in %s, expected 32 bytes, got %d", path, len(secret))
+	}
+
+	return secret, nil
+}
+
+// VerifyJWT verifies a HS256 JWT signed with the secret. Like geth authrpc, the token must have an
+// issued-at claim within jwtExpiryTimeout of the current time.
+func VerifyJWT(secret []byte, strToken string) error {
+	var claims jwt.RegisteredClaims
+
+	// the claims validation is disabled since the issued-at claim is allowed to drift
+	token, err := jwt.ParseWithClaims(strToken, &claims, func(*jwt.Token) (interface{}, error) {
+		return secret, nil
+	}, jwt.WithValidMethods([]string{"HS256"}), jwt.WithoutClaimsValidation())
+
+	switch {
+	case err != nil:
+		return err
+	case !token.Valid:
+		return errors.New("invalid token")
+	case !claims.VerifyExpiresAt(time.Now(), false):
+		return errors.New("token is expired")
+	case claims.IssuedAt == nil:
+		return errors.New("missing issued-at")
+	case time.Since(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		return errors.New("stale token")
+	case time.Until(claims.IssuedAt.Time) > jwtExpiryTimeout:
+		return errors.New("future token")
+	default:
+		return nil
+	}
+}
+
+// NewJWTHandler wraps the handler to require a valid HS256 JWT bearer token signed with the secret.
+func NewJWTHandler(secret []byte, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := verifyBearer(r, "", secret); err != nil {
+			writeHTTPErrResponse(w, http.StatusUnauthorized, errCodeUnauthorized, "unauthorized: "+err.Error())
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// verifyBearer checks the bearer token of the request against the static auth token or, when the
+// JWT secret is set, as a JWT.
+func verifyBearer(r *http.Request, authToken string, jwtSecret []byte) error {
+	auth := r.Header.Get("Authorization")
+	if !strings.HasPrefix(auth, "Bearer ") {
+		return errors.New("missing bearer token")
+	}
+	strToken := strings.TrimPrefix(auth, "Bearer ")
+
+	if authToken != "" && subtle.ConstantTimeCompare([]byte(strToken), []byte(authToken)) == 1 {
+		return nil
+	}
+
+	if len(jwtSecret) > 0 {
+		return VerifyJWT(jwtSecret, strToken)
+	}
+
+	return errors.New("invalid bearer token")
+}
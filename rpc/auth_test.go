@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadJWTSecret(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "jwt.hex")
+	require.NoError(t, os.WriteFile(path, []byte("0x0102030405060708091011121314151617181920212223242526272829303132\n"), 0o600))
+	secret, err := ReadJWTSecret(path)
+	require.NoError(t, err)
+	require.Len(t, secret, 32)
+
+	require.NoError(t, os.WriteFile(path, []byte("0102"), 0o600))
+	_, err = ReadJWTSecret(path)
+	require.Error(t, err)
+
+	_, err = ReadJWTSecret(filepath.Join(dir, "missing"))
+	require.Error(t, err)
+}
+
+func TestVerifyBearer(t *testing.T) {
+	secret := make([]byte, 32)
+	secret[0] = 1
+
+	sign := func(key []byte, iat time.Time) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+			IssuedAt: jwt.NewNumericDate(iat),
+		})
+		str, err := token.SignedString(key)
+		require.NoError(t, err)
+		return str
+	}
+
+	testCases := []struct {
+		name      string
+		auth      string
+		authToken string
+		jwtSecret []byte
+		expPass   bool
+	}{
+		{"missing header", "", "token", nil, false},
+		{"not a bearer", "Basic token", "token", nil, false},
+		{"valid token", "Bearer token", "token", nil, true},
+		{"invalid token", "Bearer other", "token", nil, false},
+		{"valid jwt", "Bearer " + sign(secret, time.Now()), "", secret, true},
+		{"jwt with wrong key", "Bearer " + sign(make([]byte, 32), time.Now()), "", secret, false},
+		{"stale jwt", "Bearer " + sign(secret, time.Now().Add(-2*time.Minute)), "", secret, false},
+		{"future jwt", "Bearer " + sign(secret, time.Now().Add(2*time.Minute)), "", secret, false},
+		{"token or jwt", "Bearer " + sign(secret, time.Now()), "token", secret, true},
+	}
+
+	for _, tc := range testCases {
+		r, err := http.NewRequest(http.MethodGet, "/", nil)
+		require.NoError(t, err)
+		if tc.auth != "" {
+			r.Header.Set("Authorization", tc.auth)
+		}
+
+		err = verifyBearer(r, tc.authToken, tc.jwtSecret)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestJWTHandler(t *testing.T) {
+	secret := make([]byte, 32)
+	secret[0] = 1
+
+	handler := NewJWTHandler(secret, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
+		IssuedAt: jwt.NewNumericDate(time.Now()),
+	}).SignedString(secret)
+	require.NoError(t, err)
+
+	r := httptest.NewRequest(http.MethodPost, "/", nil)
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Equal(t, http.StatusUnauthorized, w.Code)
+	require.Contains(t, w.Body.String(), "missing bearer token")
+
+	r.Header.Set("Authorization", "Bearer "+token)
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	require.Equal(t, http.StatusOK, w.Code)
+}
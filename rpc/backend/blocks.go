@@ -40,6 +40,15 @@ import (
 // the client to use the latest block number in abci app state than tendermint
 // rpc.
 func (b *Backend) BlockNumber() (hexutil.Uint64, error) {
+	if b.jsonRPCOnly {
+		// there's no app state in json-rpc only mode, use the latest block in block store.
+		status, err := b.clientCtx.Client.Status(b.ctx)
+		if err != nil {
+			return hexutil.Uint64(0), err
+		}
+		return hexutil.Uint64(status.SyncInfo.LatestBlockHeight), nil
+	}
+
 	// do any grpc query, ignore the response and use the returned block height
 	var header metadata.MD
 	_, err := b.queryClient.Params(b.ctx, &evmtypes.QueryParamsRequest{}, grpc.Header(&header))
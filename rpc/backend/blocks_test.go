@@ -60,6 +60,17 @@ func (suite *BackendTestSuite) TestBlockNumber() {
 			0x1,
 			true,
 		},
+		{
+			"pass - json-rpc only mode, latest block in block store",
+			func() {
+				suite.backend.jsonRPCOnly = true
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				client.On("Status", ethrpc.ContextWithHeight(1)).
+					Return(&tmrpctypes.ResultStatus{SyncInfo: tmrpctypes.SyncInfo{LatestBlockHeight: 2}}, nil)
+			},
+			0x2,
+			true,
+		},
 	}
 	for _, tc := range testCases {
 		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
@@ -117,6 +117,21 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	return b.sendRawTransaction(data, nil)
+}
+
+// SendRawTransactionWithExpiry send a raw Ethereum transaction that can't be included after the
+// given block height or time, and is evicted from the mempool once expired. The expiry is set on the
+// cosmos tx wrapper, outside of the Ethereum signature, so anyone can broadcast the signed transaction
+// again without it.
+func (b *Backend) SendRawTransactionWithExpiry(data hexutil.Bytes, expiry rpctypes.TxExpiry) (common.Hash, error) {
+	return b.sendRawTransaction(data, &evmtypes.ExtensionOptionsEthereumTxExpiry{
+		MaxBlockHeight: uint64(expiry.MaxBlockHeight),
+		MaxTimestamp:   uint64(expiry.MaxTimestamp),
+	})
+}
+
+func (b *Backend) sendRawTransaction(data hexutil.Bytes, expiry *evmtypes.ExtensionOptionsEthereumTxExpiry) (common.Hash, error) {
 	// RLP decode raw transaction bytes
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(data); err != nil {
@@ -148,7 +163,7 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
-	cosmosTx, err := ethereumTx.BuildTx(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom)
+	cosmosTx, err := ethereumTx.BuildTxWithExpiry(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom, expiry)
 	if err != nil {
 		b.logger.Error("failed to build cosmos tx", "error", err.Error())
 		return common.Hash{}, err
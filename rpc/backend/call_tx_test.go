@@ -67,6 +67,8 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterBlock(client, 1, nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFeeDisabled(queryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketMinGasPrice(feeMarketClient, 1, nil)
 			},
 			evmtypes.TransactionArgs{
 				Nonce:   &txNonce,
@@ -86,6 +88,7 @@ func (suite *BackendTestSuite) TestResend() {
 				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterParams(queryClient, &header, 1)
 				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterFeeMarketMinGasPrice(feeMarketClient, 1, nil)
 				RegisterBlock(client, 1, nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, baseFee)
@@ -108,6 +111,8 @@ func (suite *BackendTestSuite) TestResend() {
 				RegisterBlock(client, 1, nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFeeDisabled(queryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketMinGasPrice(feeMarketClient, 1, nil)
 			},
 			evmtypes.TransactionArgs{
 				Nonce:                &txNonce,
@@ -360,6 +365,66 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSendRawTransactionWithExpiry() {
+	ethTx, _ := suite.buildEthereumTx()
+	rlpEncodedBz, _ := rlp.EncodeToBytes(ethTx.AsTransaction())
+	expiry := rpctypes.TxExpiry{MaxBlockHeight: 10, MaxTimestamp: 1000}
+	cosmosTx, _ := ethTx.BuildTxWithExpiry(suite.backend.clientCtx.TxConfig.NewTxBuilder(), "aphoton", &evmtypes.ExtensionOptionsEthereumTxExpiry{
+		MaxBlockHeight: 10,
+		MaxTimestamp:   1000,
+	})
+	txBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(cosmosTx)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expiry       rpctypes.TxExpiry
+		expHash      common.Hash
+		expPass      bool
+	}{
+		{
+			"fail - empty expiry",
+			func() {
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+			},
+			rpctypes.TxExpiry{},
+			common.Hash{},
+			false,
+		},
+		{
+			"pass - broadcasts the transaction with its expiry",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBroadcastTx(client, txBytes)
+			},
+			expiry,
+			common.HexToHash(ethTx.Hash),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			hash, err := suite.backend.SendRawTransactionWithExpiry(rlpEncodedBz, tc.expiry)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expHash, hash)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestDoCall() {
 	_, bz := suite.buildEthereumTx()
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
@@ -450,6 +515,7 @@ func (suite *BackendTestSuite) TestGasPrice() {
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				RegisterFeeMarketParams(feeMarketClient, 1)
+				RegisterFeeMarketMinGasPrice(feeMarketClient, 1, nil)
 				RegisterParams(queryClient, &header, 1)
 				RegisterBlock(client, 1, nil)
 				RegisterBlockResults(client, 1)
@@ -465,6 +531,7 @@ func (suite *BackendTestSuite) TestGasPrice() {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketMinGasPrice(feeMarketClient, 1, nil)
 				RegisterFeeMarketParamsError(feeMarketClient, 1)
 				RegisterParams(queryClient, &header, 1)
 				RegisterBlock(client, 1, nil)
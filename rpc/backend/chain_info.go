@@ -22,6 +22,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/misc"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -45,7 +46,12 @@ func (b *Backend) ChainID() (*hexutil.Big, error) {
 		return (*hexutil.Big)(eip155ChainID), nil
 	}
 
-	if config := b.ChainConfig(); config.IsEIP155(new(big.Int).SetUint64(uint64(bn))) {
+	config := b.ChainConfig()
+	if config == nil {
+		// chain config can't be queried, e.g. in json-rpc only mode
+		return (*hexutil.Big)(eip155ChainID), nil
+	}
+	if config.IsEIP155(new(big.Int).SetUint64(uint64(bn))) {
 		return (*hexutil.Big)(config.ChainID), nil
 	}
 
@@ -182,6 +188,40 @@ func (b *Backend) FeeHistory(
 	blockStart := blockEnd + 1 - blocks
 	oldestBlock := (*hexutil.Big)(big.NewInt(blockStart))
 
+	// the base fees and gas used ratios stored by the fee market are fetched in a single query, the
+	// blocks are only fetched to calculate the rewards
+	if feeHistory, history, ok := b.storedFeeHistory(blockStart, blockEnd); ok {
+		if len(rewardPercentiles) == 0 {
+			return feeHistory, nil
+		}
+
+		feeHistory.Reward = make([][]*hexutil.Big, blocks)
+		for i, blockHistory := range history {
+			tendermintBlock, err := b.TendermintBlockByNumber(rpctypes.BlockNumber(blockHistory.Height))
+			if tendermintBlock == nil {
+				return nil, err
+			}
+
+			tendermintBlockResult, err := b.TendermintBlockResultByNumber(&blockHistory.Height)
+			if tendermintBlockResult == nil {
+				return nil, err
+			}
+
+			rewards := b.blockRewards(
+				tendermintBlock,
+				tendermintBlockResult,
+				feeHistory.BaseFee[i].ToInt(),
+				float64(blockHistory.GasUsed),
+				rewardPercentiles,
+			)
+			feeHistory.Reward[i] = make([]*hexutil.Big, len(rewards))
+			for j, reward := range rewards {
+				feeHistory.Reward[i][j] = (*hexutil.Big)(reward)
+			}
+		}
+		return feeHistory, nil
+	}
+
 	// prepare space
 	reward := make([][]*hexutil.Big, blocks)
 	rewardCount := len(rewardPercentiles)
@@ -250,10 +290,74 @@ func (b *Backend) FeeHistory(
 	return &feeHistory, nil
 }
 
+// storedFeeHistory returns the fee history of the given block range from the fee market store,
+// without the rewards, along with the stored history of each block. It returns false if the history
+// of any of the blocks isn't stored, e.g. if it was pruned or if the fee history is disabled.
+func (b *Backend) storedFeeHistory(blockStart, blockEnd int64) (*rpctypes.FeeHistoryResult, []feemarkettypes.BlockFeeHistory, bool) {
+	res, err := b.queryClient.FeeMarket.FeeHistory(rpctypes.ContextWithHeight(blockEnd), &feemarkettypes.QueryFeeHistoryRequest{
+		StartHeight: blockStart,
+		EndHeight:   blockEnd,
+	})
+	if err != nil {
+		b.logger.Debug("failed to query the stored fee history", "start", blockStart, "end", blockEnd, "error", err.Error())
+		return nil, nil, false
+	}
+
+	blocks := blockEnd + 1 - blockStart
+	if int64(len(res.History)) != blocks {
+		return nil, nil, false
+	}
+
+	baseFee := make([]*hexutil.Big, blocks+1)
+	gasUsedRatio := make([]float64, blocks)
+	for i, history := range res.History {
+		if history.BaseFee != nil {
+			baseFee[i] = (*hexutil.Big)(history.BaseFee.BigInt())
+		}
+
+		gasLimit := history.GasLimit
+		if gasLimit == -1 {
+			// same as BlockMaxGasFromConsensusParams for unlimited block gas
+			gasLimit = int64(^uint32(0))
+		}
+		if gasLimit <= 0 {
+			return nil, nil, false
+		}
+		gasUsedRatio[i] = float64(history.GasUsed) / float64(gasLimit)
+	}
+
+	cfg := b.ChainConfig()
+	if cfg.IsLondon(big.NewInt(blockEnd + 1)) {
+		header := b.CurrentHeader()
+		if header == nil {
+			return nil, nil, false
+		}
+		baseFee[blocks] = (*hexutil.Big)(misc.CalcBaseFee(cfg, header))
+	} else {
+		baseFee[blocks] = (*hexutil.Big)(new(big.Int))
+	}
+
+	return &rpctypes.FeeHistoryResult{
+		OldestBlock:  (*hexutil.Big)(big.NewInt(blockStart)),
+		BaseFee:      baseFee,
+		GasUsedRatio: gasUsedRatio,
+	}, res.History, true
+}
+
 // SuggestGasTipCap returns the suggested tip cap
-// Although we don't support tx prioritization yet, but we return a positive value to help client to
-// mitigate the base fee changes.
+// If the fee market min gas price oracle is enabled, the tip percentile of the recent blocks is returned.
+// Otherwise, we return a positive value to help client to mitigate the base fee changes.
 func (b *Backend) SuggestGasTipCap(baseFee *big.Int) (*big.Int, error) {
+	// suggest the tip percentile of the recent blocks tracked by the min gas price oracle, which
+	// tracks the whole gas price when the base fee is disabled
+	minGasPrice, err := b.queryClient.FeeMarket.MinGasPrice(b.ctx, &feemarkettypes.QueryMinGasPriceRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if minGasPrice.OracleTip != nil {
+		return minGasPrice.OracleTip.BigInt(), nil
+	}
+
 	if baseFee == nil {
 		// london hardfork not enabled or feemarket not enabled
 		return big.NewInt(0), nil
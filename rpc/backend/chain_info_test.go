@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	rpc "github.com/evmos/ethermint/rpc/types"
@@ -254,18 +255,57 @@ func (suite *BackendTestSuite) TestSuggestGasTipCap() {
 	}{
 		{
 			"pass - London hardfork not enabled or feemarket not enabled ",
-			func() {},
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketMinGasPrice(feeMarketClient, 1, nil)
+			},
 			nil,
 			big.NewInt(0),
 			true,
 		},
 		{
-			"pass - Gets the suggest gas tip cap ",
-			func() {},
+			"pass - Gets the min gas price oracle value without base fee",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				price := sdk.NewInt(42)
+				RegisterFeeMarketMinGasPrice(feeMarketClient, 1, &price)
+			},
 			nil,
-			big.NewInt(0),
+			big.NewInt(42),
 			true,
 		},
+		{
+			"pass - Gets the max base fee delta without min gas price oracle",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketMinGasPrice(feeMarketClient, 1, nil)
+				RegisterFeeMarketParams(feeMarketClient, 1)
+			},
+			big.NewInt(800),
+			big.NewInt(100),
+			true,
+		},
+		{
+			"pass - Gets the min gas price oracle tip",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				tip := sdk.NewInt(42)
+				RegisterFeeMarketMinGasPrice(feeMarketClient, 1, &tip)
+			},
+			big.NewInt(800),
+			big.NewInt(42),
+			true,
+		},
+		{
+			"fail - Can't query the min gas price",
+			func() {
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				RegisterFeeMarketMinGasPriceError(feeMarketClient, 1)
+			},
+			big.NewInt(800),
+			nil,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -360,7 +400,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			"fail - Tendermint block fetching error ",
 			func(validator sdk.AccAddress) {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, 1)
 				RegisterBlockError(client, ethrpc.BlockNumber(1).Int64())
 			},
 			1,
@@ -373,7 +415,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 			"fail - Eth block fetching error",
 			func(validator sdk.AccAddress) {
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, 1)
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResultsError(client, 1)
 			},
@@ -389,7 +433,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				// baseFee := sdk.NewInt(1)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, 1)
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFeeError(queryClient)
@@ -409,7 +455,9 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 				baseFee := sdk.NewInt(1)
 				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
 				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
 				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistoryError(feeMarketClient, 1, 1)
 				RegisterBlock(client, ethrpc.BlockNumber(1).Int64(), nil)
 				RegisterBlockResults(client, 1)
 				RegisterBaseFee(queryClient, baseFee)
@@ -446,3 +494,82 @@ func (suite *BackendTestSuite) TestFeeHistory() {
 		})
 	}
 }
+
+func (suite *BackendTestSuite) TestStoredFeeHistory() {
+	storedBaseFee := sdk.NewInt(2)
+
+	// a tx paying a gas price of 5, its tip is 3 with the stored base fee
+	msgEthereumTx := evmtypes.NewTx(suite.backend.chainID, 0, &common.Address{}, big.NewInt(0), 100000, big.NewInt(5), nil, nil, nil, nil)
+	txBuilder := suite.backend.clientCtx.TxConfig.NewTxBuilder()
+	suite.Require().NoError(txBuilder.SetMsgs(msgEthereumTx))
+	txBz, err := suite.backend.clientCtx.TxConfig.TxEncoder()(txBuilder.GetTx())
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name              string
+		registerMock      func()
+		rewardPercentiles []float64
+		expFeeHistory     *rpc.FeeHistoryResult
+	}{
+		{
+			"pass - fee history served from the fee market store",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistory(feeMarketClient, 1, 1, []feemarkettypes.BlockFeeHistory{
+					{Height: 1, BaseFee: &storedBaseFee, GasUsed: 500, GasWanted: 600, GasLimit: 1000},
+				})
+				RegisterBlock(client, 1, nil)
+				RegisterBlockResults(client, 1)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+			},
+			nil,
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(2)), (*hexutil.Big)(big.NewInt(1))},
+				GasUsedRatio: []float64{0.5},
+			},
+		},
+		{
+			"pass - rewards calculated with the stored base fee",
+			func() {
+				var header metadata.MD
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				feeMarketClient := suite.backend.queryClient.FeeMarket.(*mocks.FeeMarketQueryClient)
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				suite.backend.cfg.JSONRPC.FeeHistoryCap = 2
+				RegisterFeeMarketFeeHistory(feeMarketClient, 1, 1, []feemarkettypes.BlockFeeHistory{
+					{Height: 1, BaseFee: &storedBaseFee, GasUsed: 500, GasWanted: 600, GasLimit: 1000},
+				})
+				RegisterBlock(client, 1, txBz)
+				RegisterBlockResults(client, 1)
+				RegisterBaseFee(queryClient, sdk.NewInt(1))
+				RegisterParams(queryClient, &header, 1)
+				RegisterParamsWithoutHeader(queryClient, 1)
+			},
+			[]float64{50},
+			&rpc.FeeHistoryResult{
+				OldestBlock:  (*hexutil.Big)(big.NewInt(1)),
+				BaseFee:      []*hexutil.Big{(*hexutil.Big)(big.NewInt(2)), (*hexutil.Big)(big.NewInt(1))},
+				GasUsedRatio: []float64{0.5},
+				Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(3))}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			feeHistory, err := suite.backend.FeeHistory(1, 1, tc.rewardPercentiles)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFeeHistory, feeHistory)
+		})
+	}
+}
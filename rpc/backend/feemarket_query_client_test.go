@@ -1,6 +1,8 @@
 package backend
 
 import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/evmos/ethermint/rpc/backend/mocks"
 	rpc "github.com/evmos/ethermint/rpc/types"
@@ -19,3 +21,25 @@ func RegisterFeeMarketParamsError(feeMarketClient *mocks.FeeMarketQueryClient, h
 	feeMarketClient.On("Params", rpc.ContextWithHeight(height), &feemarkettypes.QueryParamsRequest{}).
 		Return(nil, sdkerrors.ErrInvalidRequest)
 }
+
+// MinGasPrice
+func RegisterFeeMarketMinGasPrice(feeMarketClient *mocks.FeeMarketQueryClient, height int64, oracleTip *sdkmath.Int) {
+	feeMarketClient.On("MinGasPrice", rpc.ContextWithHeight(height), &feemarkettypes.QueryMinGasPriceRequest{}).
+		Return(&feemarkettypes.QueryMinGasPriceResponse{MinGasPrice: sdk.ZeroDec(), OracleTip: oracleTip}, nil)
+}
+
+func RegisterFeeMarketMinGasPriceError(feeMarketClient *mocks.FeeMarketQueryClient, height int64) {
+	feeMarketClient.On("MinGasPrice", rpc.ContextWithHeight(height), &feemarkettypes.QueryMinGasPriceRequest{}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
+
+// FeeHistory
+func RegisterFeeMarketFeeHistory(feeMarketClient *mocks.FeeMarketQueryClient, start, end int64, history []feemarkettypes.BlockFeeHistory) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(end), &feemarkettypes.QueryFeeHistoryRequest{StartHeight: start, EndHeight: end}).
+		Return(&feemarkettypes.QueryFeeHistoryResponse{History: history}, nil)
+}
+
+func RegisterFeeMarketFeeHistoryError(feeMarketClient *mocks.FeeMarketQueryClient, start, end int64) {
+	feeMarketClient.On("FeeHistory", rpc.ContextWithHeight(end), &feemarkettypes.QueryFeeHistoryRequest{StartHeight: start, EndHeight: end}).
+		Return(nil, sdkerrors.ErrInvalidRequest)
+}
@@ -253,6 +253,11 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		receipt["contractAddress"] = crypto.CreateAddress(from, txData.GetNonce())
 	}
 
+	// the account which paid the fees in place of the sender
+	if res.FeePayer != "" {
+		receipt["feePayer"] = common.HexToAddress(res.FeePayer)
+	}
+
 	if dynamicTx, ok := txData.(*evmtypes.DynamicFeeTx); ok {
 		baseFee, err := b.BaseFee(blockRes)
 		if err != nil {
@@ -157,13 +157,27 @@ func (b *Backend) processBlock(
 	}
 
 	gasUsedRatio := gasusedfloat / float64(gasLimitUint64)
-	blockGasUsed := gasusedfloat
 	targetOneFeeHistory.GasUsedRatio = gasUsedRatio
+	targetOneFeeHistory.Reward = b.blockRewards(tendermintBlock, tendermintBlockResult, blockBaseFee, gasusedfloat, rewardPercentiles)
+
+	return nil
+}
+
+// blockRewards returns the effective tips of the block transactions at the given percentiles of the
+// block gas used, weighted by the gas used by each transaction.
+func (b *Backend) blockRewards(
+	tendermintBlock *tmrpctypes.ResultBlock,
+	tendermintBlockResult *tmrpctypes.ResultBlockResults,
+	blockBaseFee *big.Int,
+	blockGasUsed float64,
+	rewardPercentiles []float64,
+) []*big.Int {
+	blockHeight := tendermintBlock.Block.Height
 
 	rewardCount := len(rewardPercentiles)
-	targetOneFeeHistory.Reward = make([]*big.Int, rewardCount)
+	rewards := make([]*big.Int, rewardCount)
 	for i := 0; i < rewardCount; i++ {
-		targetOneFeeHistory.Reward[i] = big.NewInt(0)
+		rewards[i] = big.NewInt(0)
 	}
 
 	// check tendermintTxs
@@ -200,7 +214,7 @@ func (b *Backend) processBlock(
 	// return an all zero row if there are no transactions to gather data from
 	ethTxCount := len(sorter)
 	if ethTxCount == 0 {
-		return nil
+		return rewards
 	}
 
 	sort.Sort(sorter)
@@ -214,10 +228,10 @@ func (b *Backend) processBlock(
 			txIndex++
 			sumGasUsed += sorter[txIndex].gasUsed
 		}
-		targetOneFeeHistory.Reward[i] = sorter[txIndex].reward
+		rewards[i] = sorter[txIndex].reward
 	}
 
-	return nil
+	return rewards
 }
 
 // AllTxLogsFromEvents parses all ethereum logs from cosmos events
@@ -26,17 +26,44 @@ import (
 
 type UnsubscribeFunc func()
 
+// OverflowPolicy defines how the event bus treats a buffered subscriber whose buffer is full.
+type OverflowPolicy int
+
+const (
+	// OverflowDropSubscriber drops the lagging subscriber, ErrSubscriberOverflow is sent to it
+	// before its event channel is closed.
+	OverflowDropSubscriber OverflowPolicy = iota
+	// OverflowBlock blocks the publishing of the topic until the lagging subscriber catches up.
+	OverflowBlock
+)
+
+// ErrSubscriberOverflow is sent to the dropped subscriber when its buffer is full.
+var ErrSubscriberOverflow = errors.New("subscriber is dropped, event buffer is full")
+
 type EventBus interface {
 	AddTopic(name string, src <-chan coretypes.ResultEvent) error
 	RemoveTopic(name string)
 	Subscribe(name string) (<-chan coretypes.ResultEvent, UnsubscribeFunc, error)
+	// SubscribeBuffered subscribes to the topic with an event buffer of the given size, the policy
+	// decides what to do when the buffer is full. The returned error channel receives the reason
+	// when the subscriber is dropped.
+	SubscribeBuffered(name string, size int, policy OverflowPolicy) (<-chan coretypes.ResultEvent, <-chan error, UnsubscribeFunc, error)
 	Topics() []string
 }
 
+// subscriber is a subscription of a topic in the memEventBus.
+type subscriber struct {
+	ch       chan coretypes.ResultEvent
+	errCh    chan error    // receives the reason when the subscriber is dropped
+	done     chan struct{} // closed when unsubscribed
+	buffered bool
+	policy   OverflowPolicy
+}
+
 type memEventBus struct {
 	topics          map[string]<-chan coretypes.ResultEvent
 	topicsMux       *sync.RWMutex
-	subscribers     map[string]map[uint64]chan<- coretypes.ResultEvent
+	subscribers     map[string]map[uint64]*subscriber
 	subscribersMux  *sync.RWMutex
 	currentUniqueID uint64
 }
@@ -45,7 +72,7 @@ func NewEventBus() EventBus {
 	return &memEventBus{
 		topics:         make(map[string]<-chan coretypes.ResultEvent),
 		topicsMux:      new(sync.RWMutex),
-		subscribers:    make(map[string]map[uint64]chan<- coretypes.ResultEvent),
+		subscribers:    make(map[string]map[uint64]*subscriber),
 		subscribersMux: new(sync.RWMutex),
 	}
 }
@@ -91,31 +118,66 @@ func (m *memEventBus) RemoveTopic(name string) {
 }
 
 func (m *memEventBus) Subscribe(name string) (<-chan coretypes.ResultEvent, UnsubscribeFunc, error) {
+	sub := &subscriber{
+		ch:   make(chan coretypes.ResultEvent),
+		done: make(chan struct{}),
+	}
+	unsubscribe, err := m.addSubscriber(name, sub)
+	if err != nil {
+		return nil, nil, err
+	}
+	return sub.ch, unsubscribe, nil
+}
+
+func (m *memEventBus) SubscribeBuffered(
+	name string, size int, policy OverflowPolicy,
+) (<-chan coretypes.ResultEvent, <-chan error, UnsubscribeFunc, error) {
+	if size <= 0 {
+		return nil, nil, nil, errors.Errorf("invalid buffer size: %d", size)
+	}
+
+	sub := &subscriber{
+		ch:       make(chan coretypes.ResultEvent, size),
+		errCh:    make(chan error, 1),
+		done:     make(chan struct{}),
+		buffered: true,
+		policy:   policy,
+	}
+	unsubscribe, err := m.addSubscriber(name, sub)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return sub.ch, sub.errCh, unsubscribe, nil
+}
+
+func (m *memEventBus) addSubscriber(name string, sub *subscriber) (UnsubscribeFunc, error) {
 	m.topicsMux.RLock()
 	_, ok := m.topics[name]
 	m.topicsMux.RUnlock()
 
 	if !ok {
-		return nil, nil, errors.Errorf("topic not found: %s", name)
+		return nil, errors.Errorf("topic not found: %s", name)
 	}
 
-	ch := make(chan coretypes.ResultEvent)
 	m.subscribersMux.Lock()
 	defer m.subscribersMux.Unlock()
 
 	id := m.GenUniqueID()
 	if _, ok := m.subscribers[name]; !ok {
-		m.subscribers[name] = make(map[uint64]chan<- coretypes.ResultEvent)
+		m.subscribers[name] = make(map[uint64]*subscriber)
 	}
-	m.subscribers[name][id] = ch
+	m.subscribers[name][id] = sub
 
 	unsubscribe := func() {
 		m.subscribersMux.Lock()
 		defer m.subscribersMux.Unlock()
-		delete(m.subscribers[name], id)
+		if m.subscribers[name][id] == sub {
+			delete(m.subscribers[name], id)
+			close(sub.done)
+		}
 	}
 
-	return ch, unsubscribe, nil
+	return unsubscribe, nil
 }
 
 func (m *memEventBus) publishTopic(name string, src <-chan coretypes.ResultEvent) {
@@ -140,19 +202,52 @@ func (m *memEventBus) closeAllSubscribers(name string) {
 	delete(m.subscribers, name)
 
 	for _, sub := range subsribers {
-		close(sub)
+		close(sub.ch)
 	}
 }
 
 func (m *memEventBus) publishAllSubscribers(name string, msg coretypes.ResultEvent) {
 	m.subscribersMux.RLock()
-	subsribers := m.subscribers[name]
+	subsribers := make(map[uint64]*subscriber, len(m.subscribers[name]))
+	for id, sub := range m.subscribers[name] {
+		subsribers[id] = sub
+	}
 	m.subscribersMux.RUnlock()
 
-	for _, sub := range subsribers {
-		select {
-		case sub <- msg:
+	for id, sub := range subsribers {
+		switch {
+		case !sub.buffered:
+			select {
+			case sub.ch <- msg:
+			default:
+			}
+		case sub.policy == OverflowBlock:
+			select {
+			case sub.ch <- msg:
+			case <-sub.done:
+			}
 		default:
+			select {
+			case sub.ch <- msg:
+			default:
+				m.dropSubscriber(name, id, sub)
+			}
 		}
 	}
 }
+
+// dropSubscriber removes the lagging subscriber and closes its event channel.
+func (m *memEventBus) dropSubscriber(name string, id uint64, sub *subscriber) {
+	m.subscribersMux.Lock()
+	defer m.subscribersMux.Unlock()
+
+	if m.subscribers[name][id] != sub {
+		// already unsubscribed
+		return
+	}
+	delete(m.subscribers[name], id)
+	close(sub.done)
+
+	sub.errCh <- ErrSubscriberOverflow
+	close(sub.ch)
+}
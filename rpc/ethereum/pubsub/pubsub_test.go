@@ -83,3 +83,65 @@ func TestSubscribe(t *testing.T) {
 	wg.Wait()
 	time.Sleep(time.Second)
 }
+
+func TestSubscribeBuffered(t *testing.T) {
+	q := NewEventBus()
+	src := make(chan coretypes.ResultEvent)
+	require.NoError(t, q.AddTopic("kek", src))
+
+	_, _, _, err := q.SubscribeBuffered("kek", 0, OverflowDropSubscriber)
+	require.Error(t, err)
+
+	_, _, _, err = q.SubscribeBuffered("lol", 1, OverflowDropSubscriber)
+	require.Error(t, err)
+
+	dropC, dropErrC, _, err := q.SubscribeBuffered("kek", 1, OverflowDropSubscriber)
+	require.NoError(t, err)
+
+	blockC, _, unsubBlock, err := q.SubscribeBuffered("kek", 1, OverflowBlock)
+	require.NoError(t, err)
+
+	// first event fills both buffers
+	src <- coretypes.ResultEvent{Query: "1"}
+
+	// second event overflows the dropped subscriber while the blocking one waits
+	sent := make(chan struct{})
+	go func() {
+		src <- coretypes.ResultEvent{Query: "2"}
+		close(sent)
+	}()
+
+	select {
+	case err := <-dropErrC:
+		require.ErrorIs(t, err, ErrSubscriberOverflow)
+	case <-time.After(time.Second):
+		t.Fatal("subscriber not dropped")
+	}
+
+	msg, ok := <-dropC
+	require.True(t, ok)
+	require.Equal(t, "1", msg.Query)
+	_, ok = <-dropC
+	require.False(t, ok)
+
+	msg = <-blockC
+	require.Equal(t, "1", msg.Query)
+	msg = <-blockC
+	require.Equal(t, "2", msg.Query)
+	<-sent
+
+	// unsubscribing releases a blocked publisher
+	src <- coretypes.ResultEvent{Query: "3"}
+	sent = make(chan struct{})
+	go func() {
+		src <- coretypes.ResultEvent{Query: "4"}
+		close(sent)
+	}()
+	unsubBlock()
+
+	select {
+	case <-sent:
+	case <-time.After(time.Second):
+		t.Fatal("publisher still blocked")
+	}
+}
@@ -67,6 +67,7 @@ type EthereumAPI interface {
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionWithExpiry(data hexutil.Bytes, expiry rpctypes.TxExpiry) (common.Hash, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
@@ -230,6 +231,14 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	return e.backend.SendRawTransaction(data)
 }
 
+// SendRawTransactionWithExpiry send a raw Ethereum transaction that can't be included after the
+// given block height or time. The expiry isn't covered by the Ethereum signature, the same signed
+// transaction can be broadcast again without it, so it isn't a binding deadline.
+func (e *PublicAPI) SendRawTransactionWithExpiry(data hexutil.Bytes, expiry rpctypes.TxExpiry) (common.Hash, error) {
+	e.logger.Debug("eth_sendRawTransactionWithExpiry", "length", len(data), "max-height", expiry.MaxBlockHeight, "max-timestamp", expiry.MaxTimestamp)
+	return e.backend.SendRawTransactionWithExpiry(data, expiry)
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
 	e.logger.Debug("eth_sendTransaction", "args", args.String())
@@ -527,10 +527,15 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 //
 // https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
 func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
+	return getLogs(ctx, api.logger, api.backend, crit)
+}
+
+// getLogs runs a single-shot filter with the criteria and returns all the logs matched.
+func getLogs(ctx context.Context, logger log.Logger, backend Backend, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
 	var filter *Filter
 	if crit.BlockHash != nil {
 		// Block filter requested, construct a single-shot filter
-		filter = NewBlockFilter(api.logger, api.backend, crit)
+		filter = NewBlockFilter(logger, backend, crit)
 	} else {
 		// Convert the RPC block numbers into internal representations
 		begin := rpc.LatestBlockNumber.Int64()
@@ -542,11 +547,11 @@ func (api *PublicFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCrit
 			end = crit.ToBlock.Int64()
 		}
 		// Construct the range filter
-		filter = NewRangeFilter(api.logger, api.backend, begin, end, crit.Addresses, crit.Topics)
+		filter = NewRangeFilter(logger, backend, begin, end, crit.Addresses, crit.Topics)
 	}
 
 	// Run the filter and return all the logs
-	logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
+	logs, err := filter.Logs(ctx, int(backend.RPCLogsCap()), int64(backend.RPCBlockRangeCap()))
 	if err != nil {
 		return nil, err
 	}
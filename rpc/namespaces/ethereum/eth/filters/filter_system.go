@@ -121,13 +121,12 @@ func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.Unsub
 	existingSubs := es.eventBus.Topics()
 	for _, topic := range existingSubs {
 		if topic == sub.event {
-			eventCh, unsubFn, err := es.eventBus.Subscribe(sub.event)
+			unsubFn, err := es.subscribeEventBus(sub)
 			if err != nil {
 				err := errors.Wrapf(err, "failed to subscribe to topic: %s", sub.event)
 				return nil, nil, err
 			}
 
-			sub.eventCh = eventCh
 			return sub, unsubFn, nil
 		}
 	}
@@ -152,19 +151,48 @@ func (es *EventSystem) subscribe(sub *Subscription) (*Subscription, pubsub.Unsub
 	es.install <- sub
 	<-sub.installed
 
-	eventCh, unsubFn, err := es.eventBus.Subscribe(sub.event)
+	unsubFn, err := es.subscribeEventBus(sub)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "failed to subscribe to topic after installed: %s", sub.event)
 	}
 
-	sub.eventCh = eventCh
 	return sub, unsubFn, nil
 }
 
+// subscribeEventBus subscribes the subscription to its topic on the event bus, using a bounded
+// event buffer if the subscription has one.
+func (es *EventSystem) subscribeEventBus(sub *Subscription) (pubsub.UnsubscribeFunc, error) {
+	if sub.bufferSize <= 0 {
+		eventCh, unsubFn, err := es.eventBus.Subscribe(sub.event)
+		if err != nil {
+			return nil, err
+		}
+		sub.eventCh = eventCh
+		return unsubFn, nil
+	}
+
+	eventCh, droppedCh, unsubFn, err := es.eventBus.SubscribeBuffered(sub.event, sub.bufferSize, sub.policy)
+	if err != nil {
+		return nil, err
+	}
+	sub.eventCh = eventCh
+	sub.dropped = droppedCh
+	return unsubFn, nil
+}
+
 // SubscribeLogs creates a subscription that will write all logs matching the
 // given criteria to the given logs channel. Default value for the from and to
 // block is "latest". If the fromBlock > toBlock an error is returned.
 func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription, pubsub.UnsubscribeFunc, error) {
+	return es.SubscribeLogsWithBuffer(crit, 0, pubsub.OverflowDropSubscriber)
+}
+
+// SubscribeLogsWithBuffer is like SubscribeLogs but buffers up to bufferSize events for the
+// subscription, the policy decides whether a lagging subscription is dropped or blocks the
+// publishing of the events. A non-positive bufferSize creates an unbuffered subscription.
+func (es *EventSystem) SubscribeLogsWithBuffer(
+	crit filters.FilterCriteria, bufferSize int, policy pubsub.OverflowPolicy,
+) (*Subscription, pubsub.UnsubscribeFunc, error) {
 	var from, to rpc.BlockNumber
 	if crit.FromBlock == nil {
 		from = rpc.LatestBlockNumber
@@ -183,7 +211,7 @@ func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription
 	case (from == rpc.LatestBlockNumber && to == rpc.LatestBlockNumber),
 		(from >= 0 && to >= 0 && to >= from),
 		(from >= 0 && to == rpc.LatestBlockNumber):
-		return es.subscribeLogs(crit)
+		return es.subscribeLogs(crit, bufferSize, policy)
 
 	default:
 		return nil, nil, fmt.Errorf("invalid from and to block combination: from > to (%d > %d)", from, to)
@@ -192,16 +220,20 @@ func (es *EventSystem) SubscribeLogs(crit filters.FilterCriteria) (*Subscription
 
 // subscribeLogs creates a subscription that will write all logs matching the
 // given criteria to the given logs channel.
-func (es *EventSystem) subscribeLogs(crit filters.FilterCriteria) (*Subscription, pubsub.UnsubscribeFunc, error) {
+func (es *EventSystem) subscribeLogs(
+	crit filters.FilterCriteria, bufferSize int, policy pubsub.OverflowPolicy,
+) (*Subscription, pubsub.UnsubscribeFunc, error) {
 	sub := &Subscription{
-		id:        rpc.NewID(),
-		typ:       filters.LogsSubscription,
-		event:     evmEvents,
-		logsCrit:  crit,
-		created:   time.Now().UTC(),
-		logs:      make(chan []*ethtypes.Log),
-		installed: make(chan struct{}, 1),
-		err:       make(chan error, 1),
+		id:         rpc.NewID(),
+		typ:        filters.LogsSubscription,
+		event:      evmEvents,
+		logsCrit:   crit,
+		created:    time.Now().UTC(),
+		logs:       make(chan []*ethtypes.Log),
+		installed:  make(chan struct{}, 1),
+		err:        make(chan error, 1),
+		bufferSize: bufferSize,
+		policy:     policy,
 	}
 	return es.subscribe(sub)
 }
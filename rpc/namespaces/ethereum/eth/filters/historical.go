@@ -0,0 +1,47 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package filters
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/tendermint/tendermint/libs/log"
+
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// HistoricalFilterAPI offers the single-shot log queries over the historical blocks, it's served
+// in the json-rpc only mode, where the event subscriptions are not available.
+type HistoricalFilterAPI struct {
+	logger  log.Logger
+	backend Backend
+}
+
+// NewHistoricalAPI returns a new HistoricalFilterAPI instance.
+func NewHistoricalAPI(logger log.Logger, backend Backend) *HistoricalFilterAPI {
+	return &HistoricalFilterAPI{
+		logger:  logger.With("api", "filter"),
+		backend: backend,
+	}
+}
+
+// GetLogs returns logs matching the given argument that are stored within the state.
+//
+// https://github.com/ethereum/wiki/wiki/JSON-RPC#eth_getlogs
+func (api *HistoricalFilterAPI) GetLogs(ctx context.Context, crit filters.FilterCriteria) ([]*ethtypes.Log, error) {
+	return getLogs(ctx, api.logger, api.backend, crit)
+}
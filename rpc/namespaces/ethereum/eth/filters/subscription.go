@@ -23,6 +23,8 @@ import (
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
 	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/evmos/ethermint/rpc/ethereum/pubsub"
 )
 
 // Subscription defines a wrapper for the private subscription
@@ -38,6 +40,11 @@ type Subscription struct {
 	installed chan struct{} // closed when the filter is installed
 	eventCh   <-chan coretypes.ResultEvent
 	err       chan error
+
+	// bufferSize is the size of the event buffer, unbuffered when zero
+	bufferSize int
+	policy     pubsub.OverflowPolicy
+	dropped    <-chan error // receives the reason when the buffered subscription is dropped
 }
 
 // ID returns the underlying subscription RPC identifier.
@@ -75,3 +82,9 @@ func (s *Subscription) Err() <-chan error {
 func (s *Subscription) Event() <-chan coretypes.ResultEvent {
 	return s.eventCh
 }
+
+// Dropped returns the channel that receives the reason when the buffered subscription is dropped
+// by the event bus. It is nil for unbuffered subscriptions.
+func (s *Subscription) Dropped() <-chan error {
+	return s.dropped
+}
@@ -0,0 +1,93 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package eth
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/evmos/ethermint/rpc/backend"
+	rpctypes "github.com/evmos/ethermint/rpc/types"
+)
+
+// HistoricalAPI is the subset of the eth_ prefixed APIs which only need the historical blocks,
+// block results and the eth tx indexer. It's served in the json-rpc only mode, where the
+// application state is not available.
+type HistoricalAPI struct {
+	api *PublicAPI
+}
+
+// NewHistoricalAPI creates an instance of the historical ETH Web3 API.
+func NewHistoricalAPI(logger log.Logger, backend backend.EVMBackend) *HistoricalAPI {
+	return &HistoricalAPI{api: NewPublicAPI(logger, backend)}
+}
+
+// ChainId is the EIP-155 replay-protection chain id for the current ethereum chain config.
+func (e *HistoricalAPI) ChainId() (*hexutil.Big, error) { //nolint
+	return e.api.ChainId()
+}
+
+// BlockNumber returns the latest block number in the blockstore.
+func (e *HistoricalAPI) BlockNumber() (hexutil.Uint64, error) {
+	return e.api.BlockNumber()
+}
+
+// GetBlockByNumber returns the block identified by number.
+func (e *HistoricalAPI) GetBlockByNumber(ethBlockNum rpctypes.BlockNumber, fullTx bool) (map[string]interface{}, error) {
+	return e.api.GetBlockByNumber(ethBlockNum, fullTx)
+}
+
+// GetBlockByHash returns the block identified by hash.
+func (e *HistoricalAPI) GetBlockByHash(hash common.Hash, fullTx bool) (map[string]interface{}, error) {
+	return e.api.GetBlockByHash(hash, fullTx)
+}
+
+// GetBlockTransactionCountByHash returns the number of transactions in the block identified by hash.
+func (e *HistoricalAPI) GetBlockTransactionCountByHash(hash common.Hash) *hexutil.Uint {
+	return e.api.GetBlockTransactionCountByHash(hash)
+}
+
+// GetBlockTransactionCountByNumber returns the number of transactions in the block identified by number.
+func (e *HistoricalAPI) GetBlockTransactionCountByNumber(blockNum rpctypes.BlockNumber) *hexutil.Uint {
+	return e.api.GetBlockTransactionCountByNumber(blockNum)
+}
+
+// GetTransactionByHash returns the transaction identified by hash.
+func (e *HistoricalAPI) GetTransactionByHash(hash common.Hash) (*rpctypes.RPCTransaction, error) {
+	return e.api.GetTransactionByHash(hash)
+}
+
+// GetTransactionReceipt returns the transaction receipt identified by hash.
+func (e *HistoricalAPI) GetTransactionReceipt(hash common.Hash) (map[string]interface{}, error) {
+	return e.api.GetTransactionReceipt(hash)
+}
+
+// GetTransactionByBlockHashAndIndex returns the transaction identified by hash and index.
+func (e *HistoricalAPI) GetTransactionByBlockHashAndIndex(hash common.Hash, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
+	return e.api.GetTransactionByBlockHashAndIndex(hash, idx)
+}
+
+// GetTransactionByBlockNumberAndIndex returns the transaction identified by number and index.
+func (e *HistoricalAPI) GetTransactionByBlockNumberAndIndex(blockNum rpctypes.BlockNumber, idx hexutil.Uint) (*rpctypes.RPCTransaction, error) {
+	return e.api.GetTransactionByBlockNumberAndIndex(blockNum, idx)
+}
+
+// GetTransactionLogs returns the logs given a transaction hash.
+func (e *HistoricalAPI) GetTransactionLogs(txHash common.Hash) ([]*ethtypes.Log, error) {
+	return e.api.GetTransactionLogs(txHash)
+}
@@ -72,6 +72,8 @@ type ParsedTx struct {
 	EthTxIndex int32
 	GasUsed    uint64
 	Failed     bool
+	// empty if the fees are paid by the sender
+	FeePayer string
 }
 
 // NewParsedTx initialize a ParsedTx
@@ -171,6 +173,7 @@ func ParseTxIndexerResult(txResult *tmrpctypes.ResultTx, tx sdk.Tx, getter func(
 		Failed:            parsedTx.Failed,
 		GasUsed:           parsedTx.GasUsed,
 		CumulativeGasUsed: txs.AccumulativeGasUsed(parsedTx.MsgIndex),
+		FeePayer:          parsedTx.FeePayer,
 	}, nil
 }
 
@@ -260,6 +263,8 @@ func fillTxAttribute(tx *ParsedTx, key []byte, value []byte) error {
 		tx.GasUsed = gasUsed
 	case evmtypes.AttributeKeyEthereumTxFailed:
 		tx.Failed = len(value) > 0
+	case evmtypes.AttributeKeyFeePayer:
+		tx.FeePayer = string(value)
 	}
 	return nil
 }
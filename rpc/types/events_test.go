@@ -54,6 +54,7 @@ func TestParseTxResult(t *testing.T) {
 						{Key: []byte("txGasUsed"), Value: []byte("21000")},
 						{Key: []byte("txHash"), Value: []byte("14A84ED06282645EFBF080E0B7ED80D8D8D6A36337668A12B5F229F81CDD3F57")},
 						{Key: []byte("recipient"), Value: []byte("0x775b87ef5D82ca211811C1a02CE0fE0CA3a455d7")},
+						{Key: []byte("feePayer"), Value: []byte(address)},
 						{Key: []byte("ethereumTxFailed"), Value: []byte("contract reverted")},
 					}},
 					{Type: evmtypes.EventTypeTxLog, Attributes: []abci.EventAttribute{}},
@@ -73,6 +74,7 @@ func TestParseTxResult(t *testing.T) {
 					EthTxIndex: 11,
 					GasUsed:    21000,
 					Failed:     true,
+					FeePayer:   address,
 				},
 			},
 		},
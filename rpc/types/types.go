@@ -97,6 +97,14 @@ type SignTransactionResult struct {
 	Tx  *ethtypes.Transaction `json:"tx"`
 }
 
+// TxExpiry defines the last block height and time, in unix seconds, at which a transaction sent
+// with eth_sendRawTransactionWithExpiry can be included. A zero value disables the limit. The expiry
+// isn't part of the signed Ethereum transaction.
+type TxExpiry struct {
+	MaxBlockHeight hexutil.Uint64 `json:"maxBlockHeight"`
+	MaxTimestamp   hexutil.Uint64 `json:"maxTimestamp"`
+}
+
 type OneFeeHistory struct {
 	BaseFee, NextBaseFee *big.Int   // base fee for each block
 	Reward               []*big.Int // each element of the array will have the tip provided to miners for the percentile given
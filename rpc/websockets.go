@@ -24,13 +24,17 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"strings"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/gorilla/mux"
 	"github.com/gorilla/websocket"
 	"github.com/pkg/errors"
 
+	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
@@ -45,6 +49,7 @@ import (
 	rpcfilters "github.com/evmos/ethermint/rpc/namespaces/ethereum/eth/filters"
 	"github.com/evmos/ethermint/rpc/types"
 	"github.com/evmos/ethermint/server/config"
+	ethermint "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
 )
 
@@ -69,6 +74,19 @@ type SubscriptionResult struct {
 	Result       interface{} `json:"result"`
 }
 
+// SubscriptionErrorNotification notifies the client that a subscription failed and won't
+// receive further events.
+type SubscriptionErrorNotification struct {
+	Jsonrpc string             `json:"jsonrpc"`
+	Method  string             `json:"method"`
+	Params  *SubscriptionError `json:"params"`
+}
+
+type SubscriptionError struct {
+	Subscription rpc.ID            `json:"subscription"`
+	Error        *ErrorMessageJSON `json:"error"`
+}
+
 type ErrorResponseJSON struct {
 	Jsonrpc string            `json:"jsonrpc"`
 	Error   *ErrorMessageJSON `json:"error"`
@@ -80,6 +98,13 @@ type ErrorMessageJSON struct {
 	Message string   `json:"message"`
 }
 
+const (
+	// errCodeLimitExceeded is the JSON-RPC error code returned when a connection limit is exceeded
+	errCodeLimitExceeded = -32005
+	// errCodeUnauthorized is the JSON-RPC error code returned when the connection is not authenticated
+	errCodeUnauthorized = -32001
+)
+
 type websocketsServer struct {
 	rpcAddr  string // listen address of rest-server
 	wsAddr   string // listen address of ws server
@@ -87,20 +112,72 @@ type websocketsServer struct {
 	keyFile  string
 	api      *pubSubAPI
 	logger   log.Logger
+
+	allowedOrigins   map[string]bool // all origins are allowed when nil
+	maxConnections   int64
+	maxSubscriptions int
+	maxMessageSize   int64
+	idleTimeout      time.Duration
+	authToken        string
+	jwtSecret        []byte
+	connections      int64 // number of open connections, updated atomically
 }
 
-func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, cfg *config.Config) WebsocketsServer {
+func NewWebsocketsServer(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	evmBackend rpcfilters.Backend,
+	cfg *config.Config,
+) (WebsocketsServer, error) {
 	logger = logger.With("api", "websocket-server")
 	_, port, _ := net.SplitHostPort(cfg.JSONRPC.Address)
 
-	return &websocketsServer{
-		rpcAddr:  "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
-		wsAddr:   cfg.JSONRPC.WsAddress,
-		certFile: cfg.TLS.CertificatePath,
-		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
-		logger:   logger,
+	bufferSize := cfg.JSONRPC.WsSubscriptionBuffer
+	if bufferSize == 0 {
+		bufferSize = config.DefaultWsSubscriptionBuffer
+	}
+
+	policy := pubsub.OverflowDropSubscriber
+	if cfg.JSONRPC.WsOverflowPolicy == config.WsOverflowPolicyBlock {
+		policy = pubsub.OverflowBlock
+	}
+
+	var allowedOrigins map[string]bool
+	for _, origin := range cfg.JSONRPC.WsAllowedOrigins {
+		if origin == "*" {
+			allowedOrigins = nil
+			break
+		}
+		if allowedOrigins == nil {
+			allowedOrigins = make(map[string]bool)
+		}
+		allowedOrigins[strings.ToLower(origin)] = true
 	}
+
+	var jwtSecret []byte
+	if cfg.JSONRPC.WsJWTSecret != "" {
+		var err error
+		if jwtSecret, err = ReadJWTSecret(cfg.JSONRPC.WsJWTSecret); err != nil {
+			return nil, err
+		}
+	}
+
+	return &websocketsServer{
+		rpcAddr:          "localhost:" + port, // FIXME: this shouldn't be hardcoded to localhost
+		wsAddr:           cfg.JSONRPC.WsAddress,
+		certFile:         cfg.TLS.CertificatePath,
+		keyFile:          cfg.TLS.KeyPath,
+		api:              newPubSubAPI(clientCtx, logger, tmWSClient, evmBackend, bufferSize, policy),
+		logger:           logger,
+		allowedOrigins:   allowedOrigins,
+		maxConnections:   int64(cfg.JSONRPC.WsMaxConnections),
+		maxSubscriptions: cfg.JSONRPC.WsMaxSubscriptions,
+		maxMessageSize:   cfg.JSONRPC.WsMaxMessageSize,
+		idleTimeout:      cfg.JSONRPC.WsIdleTimeout,
+		authToken:        cfg.JSONRPC.WsAuthToken,
+		jwtSecret:        jwtSecret,
+	}, nil
 }
 
 func (s *websocketsServer) Start() {
@@ -127,10 +204,25 @@ func (s *websocketsServer) Start() {
 }
 
 func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.authToken != "" || len(s.jwtSecret) > 0 {
+		if err := verifyBearer(r, s.authToken, s.jwtSecret); err != nil {
+			s.logger.Debug("websocket authentication failed", "remote", r.RemoteAddr, "error", err.Error())
+			writeHTTPErrResponse(w, http.StatusUnauthorized, errCodeUnauthorized, "unauthorized: "+err.Error())
+			return
+		}
+	}
+
+	if s.maxConnections > 0 {
+		if atomic.AddInt64(&s.connections, 1) > s.maxConnections {
+			atomic.AddInt64(&s.connections, -1)
+			writeHTTPErrResponse(w, http.StatusServiceUnavailable, errCodeLimitExceeded, "too many websocket connections")
+			return
+		}
+		defer atomic.AddInt64(&s.connections, -1)
+	}
+
 	upgrader := websocket.Upgrader{
-		CheckOrigin: func(r *http.Request) bool {
-			return true
-		},
+		CheckOrigin: s.checkOrigin,
 	}
 
 	conn, err := upgrader.Upgrade(w, r, nil)
@@ -139,12 +231,54 @@ func (s *websocketsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if s.maxMessageSize > 0 {
+		conn.SetReadLimit(s.maxMessageSize)
+	}
+
+	remoteIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		remoteIP = r.RemoteAddr
+	}
+
 	s.readLoop(&wsConn{
-		mux:  new(sync.Mutex),
-		conn: conn,
+		mux:      new(sync.Mutex),
+		conn:     conn,
+		remoteIP: remoteIP,
 	})
 }
 
+// checkOrigin returns true if the request origin is allowed, requests without origin don't come
+// from browsers and are always allowed.
+func (s *websocketsServer) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if s.allowedOrigins == nil || origin == "" {
+		return true
+	}
+
+	if s.allowedOrigins[strings.ToLower(origin)] {
+		return true
+	}
+
+	s.logger.Debug("websocket origin not allowed", "origin", origin)
+	return false
+}
+
+// writeHTTPErrResponse rejects the websocket handshake with a JSON-RPC error.
+func writeHTTPErrResponse(w http.ResponseWriter, status int, code int64, msg string) {
+	res := &ErrorResponseJSON{
+		Jsonrpc: "2.0",
+		Error: &ErrorMessageJSON{
+			Code:    big.NewInt(code),
+			Message: msg,
+		},
+		ID: nil,
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
 func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	res := &ErrorResponseJSON{
 		Jsonrpc: "2.0",
@@ -158,9 +292,24 @@ func (s *websocketsServer) sendErrResponse(wsConn *wsConn, msg string) {
 	_ = wsConn.WriteJSON(res)
 }
 
+// sendErrResponseWithCode sends an error response with the code to the request of the ID.
+func (s *websocketsServer) sendErrResponseWithCode(wsConn *wsConn, code int64, msg string, id float64) {
+	res := &ErrorResponseJSON{
+		Jsonrpc: "2.0",
+		Error: &ErrorMessageJSON{
+			Code:    big.NewInt(code),
+			Message: msg,
+		},
+		ID: big.NewInt(int64(id)),
+	}
+
+	_ = wsConn.WriteJSON(res)
+}
+
 type wsConn struct {
-	conn *websocket.Conn
-	mux  *sync.Mutex
+	conn     *websocket.Conn
+	mux      *sync.Mutex
+	remoteIP string // forwarded to the rest-server to apply the rate limits to the client
 }
 
 func (w *wsConn) WriteJSON(v interface{}) error {
@@ -170,6 +319,13 @@ func (w *wsConn) WriteJSON(v interface{}) error {
 	return w.conn.WriteJSON(v)
 }
 
+func (w *wsConn) WriteControl(messageType int, data []byte) error {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+
+	return w.conn.WriteControl(messageType, data, time.Now().Add(time.Second))
+}
+
 func (w *wsConn) Close() error {
 	w.mux.Lock()
 	defer w.mux.Unlock()
@@ -194,13 +350,28 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 	}()
 
 	for {
+		if s.idleTimeout > 0 {
+			_ = wsConn.conn.SetReadDeadline(time.Now().Add(s.idleTimeout))
+		}
+
 		_, mb, err := wsConn.ReadMessage()
 		if err != nil {
+			var netErr net.Error
+			switch {
+			case errors.As(err, &netErr) && netErr.Timeout():
+				s.logger.Debug("closing idle websocket connection")
+				_ = wsConn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, "idle timeout"))
+			case errors.Is(err, websocket.ErrReadLimit):
+				s.logger.Debug("closing websocket connection, message too large", "limit", s.maxMessageSize)
+				_ = wsConn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseMessageTooBig, "message too large"))
+			default:
+				s.logger.Error("read message error, breaking read loop", "error", err.Error())
+			}
 			_ = wsConn.Close()
-			s.logger.Error("read message error, breaking read loop", "error", err.Error())
 			return
 		}
 
+		// the batches are served by the rest-server, which applies the batch limits
 		if isBatch(mb) {
 			if err := s.tcpGetAndSendResponse(wsConn, mb); err != nil {
 				s.sendErrResponse(wsConn, err.Error())
@@ -241,8 +412,21 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				continue
 			}
 
+			if s.maxSubscriptions > 0 && len(subscriptions) >= s.maxSubscriptions {
+				s.sendErrResponseWithCode(
+					wsConn,
+					errCodeLimitExceeded,
+					fmt.Sprintf("too many subscriptions, max %d per connection", s.maxSubscriptions),
+					connID,
+				)
+				continue
+			}
+
 			subID := rpc.NewID()
-			unsubFn, err := s.api.subscribe(wsConn, subID, params)
+			// ready is closed once the subscription ID is sent to the client, so the
+			// notifications that don't wait for new events are not sent before it
+			ready := make(chan struct{})
+			unsubFn, err := s.api.subscribe(wsConn, subID, params, ready)
 			if err != nil {
 				s.sendErrResponse(wsConn, err.Error())
 				continue
@@ -255,7 +439,9 @@ func (s *websocketsServer) readLoop(wsConn *wsConn) {
 				Result:  subID,
 			}
 
-			if err := wsConn.WriteJSON(res); err != nil {
+			err = wsConn.WriteJSON(res)
+			close(ready)
+			if err != nil {
 				break
 			}
 		case "eth_unsubscribe":
@@ -320,6 +506,9 @@ func (s *websocketsServer) tcpGetAndSendResponse(wsConn *wsConn, mb []byte) erro
 	}
 
 	req.Header.Set("Content-Type", "application/json")
+	if wsConn.remoteIP != "" {
+		req.Header.Set("X-Forwarded-For", wsConn.remoteIP)
+	}
 	client := &http.Client{}
 	resp, err := client.Do(req)
 	if err != nil {
@@ -347,19 +536,36 @@ type pubSubAPI struct {
 	events    *rpcfilters.EventSystem
 	logger    log.Logger
 	clientCtx client.Context
+	backend   rpcfilters.Backend
+
+	// bufferSize and overflowPolicy configure the event buffer of the log subscriptions
+	bufferSize     int
+	overflowPolicy pubsub.OverflowPolicy
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(
+	clientCtx client.Context,
+	logger log.Logger,
+	tmWSClient *rpcclient.WSClient,
+	evmBackend rpcfilters.Backend,
+	bufferSize int,
+	overflowPolicy pubsub.OverflowPolicy,
+) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
 	return &pubSubAPI{
-		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
-		logger:    logger,
-		clientCtx: clientCtx,
+		events:         rpcfilters.NewEventSystem(logger, tmWSClient),
+		logger:         logger,
+		clientCtx:      clientCtx,
+		backend:        evmBackend,
+		bufferSize:     bufferSize,
+		overflowPolicy: overflowPolicy,
 	}
 }
 
-func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribe(
+	wsConn *wsConn, subID rpc.ID, params []interface{}, ready <-chan struct{},
+) (pubsub.UnsubscribeFunc, error) {
 	method, ok := params[0].(string)
 	if !ok {
 		return nil, errors.New("invalid parameters")
@@ -371,11 +577,18 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		return api.subscribeNewHeads(wsConn, subID)
 	case "logs":
 		if len(params) > 1 {
-			return api.subscribeLogs(wsConn, subID, params[1])
+			return api.subscribeLogs(wsConn, subID, params[1], ready)
 		}
-		return api.subscribeLogs(wsConn, subID, nil)
+		return api.subscribeLogs(wsConn, subID, nil, ready)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		var fullTx bool
+		if len(params) > 1 {
+			fullTx, ok = params[1].(bool)
+			if !ok {
+				return nil, errors.New("invalid parameters, expect a boolean for full transaction objects")
+			}
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -459,8 +672,13 @@ func try(fn func(), l log.Logger, desc string) {
 	fn()
 }
 
-func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interface{}) (pubsub.UnsubscribeFunc, error) {
+// subscribeLogs subscribes to the logs matching the criteria. If the criteria has a fromBlock,
+// the historical logs from that block to the latest one are sent first, then the new logs.
+func (api *pubSubAPI) subscribeLogs(
+	wsConn *wsConn, subID rpc.ID, extra interface{}, ready <-chan struct{},
+) (pubsub.UnsubscribeFunc, error) {
 	crit := filters.FilterCriteria{}
+	fromBlock := types.EthLatestBlockNumber
 
 	if extra != nil {
 		params, ok := extra.(map[string]interface{})
@@ -498,6 +716,17 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 			}
 		}
 
+		if params["fromBlock"] != nil {
+			blockStr, ok := params["fromBlock"].(string)
+			if !ok {
+				return nil, errors.Errorf("invalid fromBlock type: %T", params["fromBlock"])
+			}
+
+			if err := fromBlock.UnmarshalJSON([]byte(fmt.Sprintf("%q", blockStr))); err != nil {
+				return nil, errors.Wrap(err, "invalid fromBlock")
+			}
+		}
+
 		if params["topics"] != nil {
 			topics, ok := params["topics"].([]interface{})
 			if !ok {
@@ -559,19 +788,70 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 		}
 	}
 
-	sub, unsubFn, err := api.events.SubscribeLogs(crit)
+	// subscribe before querying the latest block, so no log is missed between the replay and
+	// the new events
+	sub, unsubFn, err := api.events.SubscribeLogsWithBuffer(crit, api.bufferSize, api.overflowPolicy)
 	if err != nil {
 		api.logger.Error("failed to subscribe logs", "error", err.Error())
 		return nil, err
 	}
 
+	// replay is disabled when fromBlock is latest or pending
+	replay := fromBlock >= 0
+	var head int64
+	if replay {
+		header, err := api.backend.HeaderByNumber(types.EthLatestBlockNumber)
+		if err != nil || header == nil || header.Number == nil {
+			unsubFn()
+			return nil, errors.Errorf("failed to fetch latest header: %v", err)
+		}
+		head = header.Number.Int64()
+
+		if blockLimit := int64(api.backend.RPCBlockRangeCap()); head-fromBlock.Int64() > blockLimit {
+			unsubFn()
+			return nil, errors.Errorf("maximum [fromBlock, latest] blocks distance: %d", blockLimit)
+		}
+	}
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+
 	go func() {
+		select {
+		case <-ready:
+		case <-ctx.Done():
+			return
+		}
+
+		if replay {
+			filter := rpcfilters.NewRangeFilter(api.logger, api.backend, fromBlock.Int64(), head, crit.Addresses, crit.Topics)
+			logs, err := filter.Logs(ctx, int(api.backend.RPCLogsCap()), int64(api.backend.RPCBlockRangeCap()))
+			if err != nil {
+				api.logger.Debug("failed to replay logs", "subscription-id", subID, "error", err.Error())
+				api.sendSubscriptionError(wsConn, subID, errors.Wrap(err, "failed to replay logs"))
+				unsubFn()
+				return
+			}
+
+			for _, ethLog := range logs {
+				if !api.sendLog(wsConn, subID, ethLog) {
+					return
+				}
+			}
+		}
+
 		ch := sub.Event()
 		errCh := sub.Err()
+		droppedCh := sub.Dropped()
 		for {
 			select {
 			case event, ok := <-ch:
 				if !ok {
+					// the event channel of a dropped subscription is closed after the overflow error is
+					// sent, which may not have been selected yet
+					if err := droppedErr(droppedCh); err != nil {
+						api.logger.Debug("dropping lagging Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
+						api.sendSubscriptionError(wsConn, subID, err)
+					}
 					return
 				}
 
@@ -581,49 +861,117 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 					continue
 				}
 
+				// skip the logs already sent by the replay
+				if replay && dataTx.Height <= head {
+					continue
+				}
+
 				txResponse, err := evmtypes.DecodeTxResponse(dataTx.TxResult.Result.Data)
 				if err != nil {
 					api.logger.Error("failed to decode tx response", "error", err.Error())
-					return
-				}
-
-				logs := rpcfilters.FilterLogs(evmtypes.LogsToEthereum(txResponse.Logs), crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
-				if len(logs) == 0 {
+					api.sendSubscriptionError(wsConn, subID, errors.Wrap(err, "failed to decode tx response"))
 					continue
 				}
 
+				logs := rpcfilters.FilterLogs(evmtypes.LogsToEthereum(txResponse.Logs), crit.FromBlock, crit.ToBlock, crit.Addresses, crit.Topics)
 				for _, ethLog := range logs {
-					res := &SubscriptionNotification{
-						Jsonrpc: "2.0",
-						Method:  "eth_subscription",
-						Params: &SubscriptionResult{
-							Subscription: subID,
-							Result:       ethLog,
-						},
-					}
-
-					err = wsConn.WriteJSON(res)
-					if err != nil {
-						try(func() {
-							if err != websocket.ErrCloseSent {
-								_ = wsConn.Close()
-							}
-						}, api.logger, "closing websocket peer sub")
+					if !api.sendLog(wsConn, subID, ethLog) {
+						return
 					}
 				}
+			case err, ok := <-droppedCh:
+				if !ok {
+					return
+				}
+				api.logger.Debug("dropping lagging Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
+				api.sendSubscriptionError(wsConn, subID, err)
+				return
 			case err, ok := <-errCh:
 				if !ok {
 					return
 				}
 				api.logger.Debug("dropping Logs WebSocket subscription", "subscription-id", subID, "error", err.Error())
+			case <-ctx.Done():
+				return
 			}
 		}
 	}()
 
-	return unsubFn, nil
+	return func() {
+		cancelFn()
+		unsubFn()
+	}, nil
+}
+
+// sendLog sends the log to the subscription, it closes the connection and returns false if the
+// write fails.
+func (api *pubSubAPI) sendLog(wsConn *wsConn, subID rpc.ID, ethLog *ethtypes.Log) bool {
+	res := &SubscriptionNotification{
+		Jsonrpc: "2.0",
+		Method:  "eth_subscription",
+		Params: &SubscriptionResult{
+			Subscription: subID,
+			Result:       ethLog,
+		},
+	}
+
+	if err := wsConn.WriteJSON(res); err != nil {
+		api.logger.Debug("failed to write log notification", "subscription-id", subID, "error", err.Error())
+		try(func() {
+			if err != websocket.ErrCloseSent {
+				_ = wsConn.Close()
+			}
+		}, api.logger, "closing websocket peer sub")
+		return false
+	}
+
+	return true
+}
+
+// droppedErr returns the error sent on the dropped channel of a subscription, without blocking, or nil
+// if the subscription wasn't dropped.
+func droppedErr(droppedCh <-chan error) error {
+	select {
+	case err, ok := <-droppedCh:
+		if ok {
+			return err
+		}
+	default:
+	}
+	return nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+// sendSubscriptionError notifies the client of a subscription error.
+func (api *pubSubAPI) sendSubscriptionError(wsConn *wsConn, subID rpc.ID, err error) {
+	res := &SubscriptionErrorNotification{
+		Jsonrpc: "2.0",
+		Method:  "eth_subscription",
+		Params: &SubscriptionError{
+			Subscription: subID,
+			Error: &ErrorMessageJSON{
+				Code:    big.NewInt(-32000),
+				Message: err.Error(),
+			},
+		},
+	}
+
+	if err := wsConn.WriteJSON(res); err != nil {
+		api.logger.Debug("failed to write subscription error", "subscription-id", subID, "error", err.Error())
+	}
+}
+
+// subscribePendingTransactions streams the hashes of the pending ethereum txs, or the full tx objects
+// if fullTx is true, same as the `newPendingTransactions` subscription of geth.
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool) (pubsub.UnsubscribeFunc, error) {
+	var chainID *big.Int
+	if fullTx {
+		var err error
+		chainID, err = ethermint.ParseChainID(api.clientCtx.ChainID)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid chain id")
+		}
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
 		return nil, errors.Wrap(err, "error creating block filter: %s")
@@ -648,13 +996,24 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 				}
 
 				for _, ethTx := range ethTxs {
+					var result interface{} = ethTx.Hash
+					if fullTx {
+						// use zero block values since it's not included in a block yet
+						rpcTx, err := types.NewTransactionFromMsg(ethTx, common.Hash{}, 0, 0, nil, chainID)
+						if err != nil {
+							api.logger.Debug("failed to build rpc transaction", "hash", ethTx.Hash, "error", err.Error())
+							continue
+						}
+						result = rpcTx
+					}
+
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       result,
 						},
 					}
 
@@ -681,8 +1040,102 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 	return unsubFn, nil
 }
 
+// syncingPollInterval is the interval of polling the tendermint status for the syncing subscriptions.
+const syncingPollInterval = 5 * time.Second
+
+// SyncingResult is the notification of the syncing subscription when the node is catching up,
+// `false` is sent instead once the node is synced, same as geth.
+type SyncingResult struct {
+	Syncing bool                  `json:"syncing"`
+	Status  ethereum.SyncProgress `json:"status"`
+}
+
+// subscribeSyncing polls the tendermint status, notifies when the node starts catching up,
+// the progress while catching up, and when it stops.
 func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+	if api.clientCtx.Client == nil {
+		return nil, errors.New("syncing subscription is not supported, tendermint client is not available")
+	}
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	status, err := api.clientCtx.Client.Status(ctx)
+	if err != nil {
+		cancelFn()
+		return nil, errors.Wrap(err, "failed to query tendermint status")
+	}
+
+	go func() {
+		ticker := time.NewTicker(syncingPollInterval)
+		defer ticker.Stop()
+
+		var (
+			syncing       bool
+			startingBlock uint64
+			currentBlock  uint64
+		)
+		for {
+			var result interface{}
+			latestBlock := uint64(status.SyncInfo.LatestBlockHeight)
+			switch {
+			case status.SyncInfo.CatchingUp && (!syncing || latestBlock != currentBlock):
+				if !syncing {
+					syncing = true
+					startingBlock = latestBlock
+				}
+				currentBlock = latestBlock
+				result = &SyncingResult{
+					Syncing: true,
+					Status: ethereum.SyncProgress{
+						StartingBlock: startingBlock,
+						CurrentBlock:  currentBlock,
+						// the highest block of the network is not known by tendermint
+						HighestBlock: currentBlock,
+					},
+				}
+			case !status.SyncInfo.CatchingUp && syncing:
+				syncing = false
+				result = false
+			}
+
+			if result != nil {
+				res := &SubscriptionNotification{
+					Jsonrpc: "2.0",
+					Method:  "eth_subscription",
+					Params: &SubscriptionResult{
+						Subscription: subID,
+						Result:       result,
+					},
+				}
+
+				if err := wsConn.WriteJSON(res); err != nil {
+					api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+					try(func() {
+						if err != websocket.ErrCloseSent {
+							_ = wsConn.Close()
+						}
+					}, api.logger, "closing websocket peer sub")
+					cancelFn()
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			res, err := api.clientCtx.Client.Status(ctx)
+			if err != nil {
+				api.logger.Debug("failed to query tendermint status", "subscription-id", subID, "error", err.Error())
+				continue
+			}
+			status = res
+		}
+	}()
+
+	return pubsub.UnsubscribeFunc(cancelFn), nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -0,0 +1,42 @@
+package rpc
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/evmos/ethermint/rpc/ethereum/pubsub"
+)
+
+func TestDroppedErr(t *testing.T) {
+	bus := pubsub.NewEventBus()
+	src := make(chan coretypes.ResultEvent)
+	require.NoError(t, bus.AddTopic("logs", src))
+
+	ch, droppedCh, unsubFn, err := bus.SubscribeBuffered("logs", 1, pubsub.OverflowDropSubscriber)
+	require.NoError(t, err)
+	defer unsubFn()
+
+	require.Nil(t, droppedErr(droppedCh))
+
+	// the second event overflows the buffer and drops the subscription, the third one is only received
+	// once the second one is published
+	src <- coretypes.ResultEvent{Query: "1"}
+	src <- coretypes.ResultEvent{Query: "2"}
+	src <- coretypes.ResultEvent{Query: "3"}
+
+	// drain the buffered events until the event channel is closed, without reading the dropped channel
+	timeout := time.After(time.Second)
+	for closed := false; !closed; {
+		select {
+		case _, ok := <-ch:
+			closed = !ok
+		case <-timeout:
+			t.Fatal("subscription not dropped")
+		}
+	}
+
+	require.ErrorIs(t, droppedErr(droppedCh), pubsub.ErrSubscriberOverflow)
+}
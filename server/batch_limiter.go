@@ -0,0 +1,166 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+// geth compatible batch errors
+const (
+	errCodeInvalidRequest   = -32600
+	errCodeResponseTooLarge = -32003
+	errMsgBatchTooLarge     = "batch too large"
+	errMsgResponseTooLarge  = "response too large"
+)
+
+// batchLimiter is a http middleware limiting the number of requests in a JSON-RPC batch and the
+// total size of the batch response, with the same errors as geth BatchRequestLimit and
+// BatchResponseMaxSize. The batch requests are served one by one so the responses after the size
+// limit are replaced by errors.
+type batchLimiter struct {
+	next         http.Handler
+	requestLimit int
+	maxRespSize  int
+}
+
+// newBatchLimiter wraps the handler with the batch limits, a zero limit disables it.
+func newBatchLimiter(next http.Handler, requestLimit, maxRespSize int) *batchLimiter {
+	return &batchLimiter{
+		next:         next,
+		requestLimit: requestLimit,
+		maxRespSize:  maxRespSize,
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (bl *batchLimiter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	trimmed := bytes.TrimLeft(body, " \t\r\n")
+	if len(trimmed) == 0 || trimmed[0] != '[' {
+		bl.next.ServeHTTP(w, r)
+		return
+	}
+
+	var msgs []json.RawMessage
+	if err := json.Unmarshal(trimmed, &msgs); err != nil || len(msgs) == 0 {
+		// let the rpc server answer the malformed and empty batches
+		bl.next.ServeHTTP(w, r)
+		return
+	}
+
+	ids := make([]json.RawMessage, len(msgs))
+	for i, msg := range msgs {
+		ids[i] = requestID(msg)
+	}
+
+	if bl.requestLimit > 0 && len(msgs) > bl.requestLimit {
+		// the protocol can't report an error for the whole batch, use the id of the first call
+		var id json.RawMessage
+		for _, reqID := range ids {
+			if reqID != nil {
+				id = reqID
+				break
+			}
+		}
+		writeJSON(w, []json.RawMessage{errorResponse(id, errCodeInvalidRequest, errMsgBatchTooLarge)})
+		return
+	}
+
+	resps := make([]json.RawMessage, 0, len(msgs))
+	size := 0
+	for i, msg := range msgs {
+		if bl.maxRespSize > 0 && size > bl.maxRespSize {
+			if ids[i] != nil {
+				resps = append(resps, errorResponse(ids[i], errCodeResponseTooLarge, errMsgResponseTooLarge))
+			}
+			continue
+		}
+
+		req := r.Clone(r.Context())
+		req.Body = io.NopCloser(bytes.NewReader(msg))
+		req.ContentLength = int64(len(msg))
+
+		buf := newResponseBuffer()
+		bl.next.ServeHTTP(buf, req)
+
+		resp := bytes.TrimSpace(buf.body.Bytes())
+		switch {
+		case len(resp) == 0:
+			// notifications have no response
+			continue
+		case !json.Valid(resp):
+			resp = errorResponse(ids[i], errCodeInvalidRequest, string(resp))
+		}
+
+		size += len(resp)
+		resps = append(resps, resp)
+	}
+
+	writeJSON(w, resps)
+}
+
+// responseBuffer is a http.ResponseWriter buffering the response of a request of the batch.
+type responseBuffer struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+func newResponseBuffer() *responseBuffer {
+	return &responseBuffer{header: make(http.Header)}
+}
+
+// Header implements http.ResponseWriter
+func (rb *responseBuffer) Header() http.Header {
+	return rb.header
+}
+
+// Write implements http.ResponseWriter
+func (rb *responseBuffer) Write(p []byte) (int, error) {
+	return rb.body.Write(p)
+}
+
+// WriteHeader implements http.ResponseWriter, the status is not used as the batch responses are
+// always answered with the 200 status.
+func (rb *responseBuffer) WriteHeader(int) {}
+
+// requestID returns the id of the request, or nil for the notifications.
+func requestID(msg json.RawMessage) json.RawMessage {
+	var req struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return json.RawMessage("null")
+	}
+	return req.ID
+}
+
+func errorResponse(id json.RawMessage, code int, msg string) json.RawMessage {
+	resp, _ := json.Marshal(newRPCErrorResponse(id, code, msg))
+	return resp
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
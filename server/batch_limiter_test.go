@@ -0,0 +1,96 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchLimiter(t *testing.T) {
+	// echo the request id with a result of 100 bytes, notifications have no response
+	calls := 0
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		id := requestID(body)
+		if id == nil {
+			return
+		}
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":"%s"}`, id, strings.Repeat("a", 100))
+	})
+
+	type response struct {
+		ID     int    `json:"id"`
+		Result string `json:"result"`
+		Error  *struct {
+			Code    int    `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+
+	call := func(bl *batchLimiter, body string) []response {
+		calls = 0
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		bl.ServeHTTP(w, r)
+
+		var resps []response
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resps), w.Body.String())
+		return resps
+	}
+
+	batch := `[{"jsonrpc":"2.0","method":"eth_subscription"},{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},` +
+		`{"jsonrpc":"2.0","id":2,"method":"eth_chainId"},{"jsonrpc":"2.0","id":3,"method":"eth_chainId"}]`
+
+	t.Run("no limits", func(t *testing.T) {
+		resps := call(newBatchLimiter(next, 0, 0), batch)
+		require.Len(t, resps, 3)
+		require.Equal(t, 4, calls)
+	})
+
+	t.Run("batch too large", func(t *testing.T) {
+		resps := call(newBatchLimiter(next, 3, 0), batch)
+		require.Len(t, resps, 1)
+		require.Equal(t, 1, resps[0].ID)
+		require.Equal(t, errCodeInvalidRequest, resps[0].Error.Code)
+		require.Equal(t, errMsgBatchTooLarge, resps[0].Error.Message)
+		require.Equal(t, 0, calls)
+	})
+
+	t.Run("response too large", func(t *testing.T) {
+		resps := call(newBatchLimiter(next, 4, 150), batch)
+		require.Len(t, resps, 3)
+		require.Equal(t, 3, calls)
+		require.Nil(t, resps[0].Error)
+		require.Nil(t, resps[1].Error)
+		require.Equal(t, 3, resps[2].ID)
+		require.Equal(t, errCodeResponseTooLarge, resps[2].Error.Code)
+		require.Equal(t, errMsgResponseTooLarge, resps[2].Error.Message)
+	})
+
+	t.Run("single request", func(t *testing.T) {
+		calls = 0
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`))
+		w := httptest.NewRecorder()
+		newBatchLimiter(next, 1, 1).ServeHTTP(w, r)
+		require.Equal(t, 1, calls)
+		require.Contains(t, w.Body.String(), `"result"`)
+	})
+
+	t.Run("request too large", func(t *testing.T) {
+		calls = 0
+		body := io.MultiReader(strings.NewReader(batch), strings.NewReader(strings.Repeat(" ", maxRequestContentLength)))
+		r := httptest.NewRequest(http.MethodPost, "/", body)
+		w := httptest.NewRecorder()
+		newBatchLimiter(next, 0, 0).ServeHTTP(w, r)
+		require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		require.Equal(t, 0, calls)
+	})
+}
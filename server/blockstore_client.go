@@ -0,0 +1,296 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	"github.com/tendermint/tendermint/libs/service"
+	"github.com/tendermint/tendermint/p2p"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// errNoAppState is returned for the queries that need the application state, which is not available
+// in the json-rpc only mode.
+var errNoAppState = errors.New("application state is not available in json-rpc only mode")
+
+// errNotSupported is returned for the queries that need a running node, which is not available in the
+// json-rpc only mode.
+var errNotSupported = errors.New("not supported in json-rpc only mode")
+
+var _ rpcclient.Client = (*blockStoreClient)(nil)
+
+// blockStoreClient is a read-only tendermint rpc client which serves the historical blocks and block
+// results directly from the local blockstore and state store, it's used in the json-rpc only mode in
+// which there's no running node. The methods which need a running node return errNotSupported.
+type blockStoreClient struct {
+	*service.BaseService
+
+	chainID    string
+	blockStore *tmstore.BlockStore
+	stateStore sm.Store
+}
+
+// newBlockStoreClient creates a blockStoreClient on top of the opened blockstore and state store.
+func newBlockStoreClient(chainID string, blockStore *tmstore.BlockStore, stateStore sm.Store) *blockStoreClient {
+	c := &blockStoreClient{
+		chainID:    chainID,
+		blockStore: blockStore,
+		stateStore: stateStore,
+	}
+	c.BaseService = service.NewBaseService(nil, "BlockStoreClient", c)
+	return c
+}
+
+// Status returns the latest block in the blockstore, the node is never catching up.
+func (c *blockStoreClient) Status(context.Context) (*ctypes.ResultStatus, error) {
+	var (
+		latestHeight   = c.blockStore.Height()
+		earliestHeight = c.blockStore.Base()
+		syncInfo       = ctypes.SyncInfo{LatestBlockHeight: latestHeight, EarliestBlockHeight: earliestHeight}
+	)
+
+	if meta := c.blockStore.LoadBlockMeta(latestHeight); meta != nil {
+		syncInfo.LatestBlockHash = meta.BlockID.Hash
+		syncInfo.LatestAppHash = meta.Header.AppHash
+		syncInfo.LatestBlockTime = meta.Header.Time
+	}
+	if meta := c.blockStore.LoadBlockMeta(earliestHeight); meta != nil {
+		syncInfo.EarliestBlockHash = meta.BlockID.Hash
+		syncInfo.EarliestAppHash = meta.Header.AppHash
+		syncInfo.EarliestBlockTime = meta.Header.Time
+	}
+
+	return &ctypes.ResultStatus{
+		NodeInfo: p2p.DefaultNodeInfo{Network: c.chainID},
+		SyncInfo: syncInfo,
+	}, nil
+}
+
+// Block returns the block at the given height, the latest one if height is nil.
+func (c *blockStoreClient) Block(_ context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	h, err := c.getHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	blockMeta := c.blockStore.LoadBlockMeta(h)
+	if blockMeta == nil {
+		return &ctypes.ResultBlock{BlockID: tmtypes.BlockID{}, Block: nil}, nil
+	}
+	return &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: c.blockStore.LoadBlock(h)}, nil
+}
+
+// BlockByHash returns the block with the given hash.
+func (c *blockStoreClient) BlockByHash(_ context.Context, hash []byte) (*ctypes.ResultBlock, error) {
+	block := c.blockStore.LoadBlockByHash(hash)
+	if block == nil {
+		return &ctypes.ResultBlock{BlockID: tmtypes.BlockID{}, Block: nil}, nil
+	}
+
+	blockMeta := c.blockStore.LoadBlockMeta(block.Height)
+	return &ctypes.ResultBlock{BlockID: blockMeta.BlockID, Block: block}, nil
+}
+
+// BlockResults returns the ABCI results of the block at the given height, the latest one if height is nil.
+func (c *blockStoreClient) BlockResults(_ context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	h, err := c.getHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := c.stateStore.LoadABCIResponses(h)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ctypes.ResultBlockResults{
+		Height:                h,
+		TxsResults:            results.DeliverTxs,
+		BeginBlockEvents:      results.BeginBlock.Events,
+		EndBlockEvents:        results.EndBlock.Events,
+		ValidatorUpdates:      results.EndBlock.ValidatorUpdates,
+		ConsensusParamUpdates: results.EndBlock.ConsensusParamUpdates,
+	}, nil
+}
+
+// ConsensusParams returns the consensus params at the given height, the latest one if height is nil.
+func (c *blockStoreClient) ConsensusParams(_ context.Context, height *int64) (*ctypes.ResultConsensusParams, error) {
+	h, err := c.getHeight(height)
+	if err != nil {
+		return nil, err
+	}
+
+	consensusParams, err := c.stateStore.LoadConsensusParams(h)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultConsensusParams{BlockHeight: h, ConsensusParams: consensusParams}, nil
+}
+
+// UnconfirmedTxs returns an empty result, there's no mempool in json-rpc only mode.
+func (c *blockStoreClient) UnconfirmedTxs(context.Context, *int) (*ctypes.ResultUnconfirmedTxs, error) {
+	return &ctypes.ResultUnconfirmedTxs{Txs: []tmtypes.Tx{}}, nil
+}
+
+// NumUnconfirmedTxs returns an empty result, there's no mempool in json-rpc only mode.
+func (c *blockStoreClient) NumUnconfirmedTxs(context.Context) (*ctypes.ResultUnconfirmedTxs, error) {
+	return &ctypes.ResultUnconfirmedTxs{}, nil
+}
+
+// ABCIQuery is not supported, there's no application state in json-rpc only mode.
+func (c *blockStoreClient) ABCIQuery(context.Context, string, tmbytes.HexBytes) (*ctypes.ResultABCIQuery, error) {
+	return nil, errNoAppState
+}
+
+// ABCIQueryWithOptions is not supported, there's no application state in json-rpc only mode.
+func (c *blockStoreClient) ABCIQueryWithOptions(
+	context.Context, string, tmbytes.HexBytes, rpcclient.ABCIQueryOptions,
+) (*ctypes.ResultABCIQuery, error) {
+	return nil, errNoAppState
+}
+
+// TxSearch is not supported, the custom eth tx indexer should be used in json-rpc only mode.
+func (c *blockStoreClient) TxSearch(context.Context, string, bool, *int, *int, string) (*ctypes.ResultTxSearch, error) {
+	return nil, errors.New("tendermint tx indexer is not available in json-rpc only mode")
+}
+
+// BroadcastTxSync is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) BroadcastTxSync(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return nil, errors.New("broadcasting tx is not supported in json-rpc only mode")
+}
+
+// BroadcastTxAsync is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) BroadcastTxAsync(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTx, error) {
+	return nil, errors.New("broadcasting tx is not supported in json-rpc only mode")
+}
+
+// BroadcastTxCommit is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) BroadcastTxCommit(context.Context, tmtypes.Tx) (*ctypes.ResultBroadcastTxCommit, error) {
+	return nil, errors.New("broadcasting tx is not supported in json-rpc only mode")
+}
+
+// ABCIInfo is not supported, there's no application state in json-rpc only mode.
+func (c *blockStoreClient) ABCIInfo(context.Context) (*ctypes.ResultABCIInfo, error) {
+	return nil, errNoAppState
+}
+
+// Commit is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) Commit(context.Context, *int64) (*ctypes.ResultCommit, error) {
+	return nil, errNotSupported
+}
+
+// Validators is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) Validators(context.Context, *int64, *int, *int) (*ctypes.ResultValidators, error) {
+	return nil, errNotSupported
+}
+
+// Tx is not supported, the custom eth tx indexer should be used in json-rpc only mode.
+func (c *blockStoreClient) Tx(context.Context, []byte, bool) (*ctypes.ResultTx, error) {
+	return nil, errNotSupported
+}
+
+// BlockSearch is not supported, there's no tendermint block indexer in json-rpc only mode.
+func (c *blockStoreClient) BlockSearch(context.Context, string, *int, *int, string) (*ctypes.ResultBlockSearch, error) {
+	return nil, errNotSupported
+}
+
+// Genesis is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) Genesis(context.Context) (*ctypes.ResultGenesis, error) {
+	return nil, errNotSupported
+}
+
+// GenesisChunked is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) GenesisChunked(context.Context, uint) (*ctypes.ResultGenesisChunk, error) {
+	return nil, errNotSupported
+}
+
+// BlockchainInfo is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) BlockchainInfo(context.Context, int64, int64) (*ctypes.ResultBlockchainInfo, error) {
+	return nil, errNotSupported
+}
+
+// NetInfo is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) NetInfo(context.Context) (*ctypes.ResultNetInfo, error) {
+	return nil, errNotSupported
+}
+
+// DumpConsensusState is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) DumpConsensusState(context.Context) (*ctypes.ResultDumpConsensusState, error) {
+	return nil, errNotSupported
+}
+
+// ConsensusState is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) ConsensusState(context.Context) (*ctypes.ResultConsensusState, error) {
+	return nil, errNotSupported
+}
+
+// Health is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) Health(context.Context) (*ctypes.ResultHealth, error) {
+	return nil, errNotSupported
+}
+
+// Subscribe is not supported, there are no new events in json-rpc only mode.
+func (c *blockStoreClient) Subscribe(context.Context, string, string, ...int) (<-chan ctypes.ResultEvent, error) {
+	return nil, errNotSupported
+}
+
+// Unsubscribe is not supported, there are no new events in json-rpc only mode.
+func (c *blockStoreClient) Unsubscribe(context.Context, string, string) error {
+	return errNotSupported
+}
+
+// UnsubscribeAll is not supported, there are no new events in json-rpc only mode.
+func (c *blockStoreClient) UnsubscribeAll(context.Context, string) error {
+	return errNotSupported
+}
+
+// CheckTx is not supported, there's no application state in json-rpc only mode.
+func (c *blockStoreClient) CheckTx(context.Context, tmtypes.Tx) (*ctypes.ResultCheckTx, error) {
+	return nil, errNoAppState
+}
+
+// BroadcastEvidence is not supported, there's no running node in json-rpc only mode.
+func (c *blockStoreClient) BroadcastEvidence(context.Context, tmtypes.Evidence) (*ctypes.ResultBroadcastEvidence, error) {
+	return nil, errNotSupported
+}
+
+// getHeight validates the height against the blockstore, same as the one in tendermint rpc.
+func (c *blockStoreClient) getHeight(heightPtr *int64) (int64, error) {
+	latestHeight := c.blockStore.Height()
+	if heightPtr == nil {
+		return latestHeight, nil
+	}
+
+	height := *heightPtr
+	if height <= 0 {
+		return 0, fmt.Errorf("height must be greater than 0, but got %d", height)
+	}
+	if height > latestHeight {
+		return 0, fmt.Errorf("height %d must be less than or equal to the current blockchain height %d", height, latestHeight)
+	}
+	if base := c.blockStore.Base(); height < base {
+		return 0, fmt.Errorf("height %d is not available, lowest height is %d", height, base)
+	}
+	return height, nil
+}
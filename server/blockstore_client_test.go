@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+	tmstate "github.com/tendermint/tendermint/proto/tendermint/state"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestBlockStoreClient(t *testing.T) {
+	// the blockstore is pruned below height 3, its latest block is at height 5
+	blockStore := tmstore.NewBlockStore(dbm.NewMemDB())
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	for h := int64(3); h <= 5; h++ {
+		block := tmtypes.MakeBlock(h, []tmtypes.Tx{{byte(h)}}, new(tmtypes.Commit), nil)
+		block.ProposerAddress = make([]byte, crypto.AddressSize)
+		block.ValidatorsHash = make([]byte, tmhash.Size) // the header hash is empty without it
+		blockStore.SaveBlock(block, block.MakePartSet(tmtypes.BlockPartSizeBytes), tmtypes.NewCommit(h, 0, tmtypes.BlockID{}, nil))
+
+		err := stateStore.SaveABCIResponses(h, &tmstate.ABCIResponses{
+			DeliverTxs: []*abci.ResponseDeliverTx{{GasUsed: h}},
+			BeginBlock: &abci.ResponseBeginBlock{},
+			EndBlock:   &abci.ResponseEndBlock{},
+		})
+		require.NoError(t, err)
+	}
+
+	c := newBlockStoreClient("ethermint_9000-1", blockStore, stateStore)
+	ctx := context.Background()
+	height := func(h int64) *int64 { return &h }
+
+	t.Run("getHeight", func(t *testing.T) {
+		testCases := []struct {
+			name      string
+			height    *int64
+			expHeight int64
+			expPass   bool
+		}{
+			{"latest", nil, 5, true},
+			{"base", height(3), 3, true},
+			{"zero", height(0), 0, false},
+			{"negative", height(-1), 0, false},
+			{"pruned", height(2), 0, false},
+			{"future", height(6), 0, false},
+		}
+
+		for _, tc := range testCases {
+			h, err := c.getHeight(tc.height)
+			if tc.expPass {
+				require.NoError(t, err, tc.name)
+				require.Equal(t, tc.expHeight, h, tc.name)
+			} else {
+				require.Error(t, err, tc.name)
+			}
+		}
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		res, err := c.Block(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(5), res.Block.Height)
+		require.NotEmpty(t, res.BlockID.Hash)
+		require.Equal(t, res.Block.Hash(), res.BlockID.Hash)
+
+		res, err = c.Block(ctx, height(4))
+		require.NoError(t, err)
+		require.Equal(t, int64(4), res.Block.Height)
+		require.Equal(t, tmtypes.Txs{{4}}, res.Block.Txs)
+
+		byHash, err := c.BlockByHash(ctx, res.BlockID.Hash)
+		require.NoError(t, err)
+		require.Equal(t, res.BlockID, byHash.BlockID)
+
+		_, err = c.Block(ctx, height(6))
+		require.Error(t, err)
+	})
+
+	t.Run("BlockResults", func(t *testing.T) {
+		res, err := c.BlockResults(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, int64(5), res.Height)
+
+		res, err = c.BlockResults(ctx, height(4))
+		require.NoError(t, err)
+		require.Equal(t, int64(4), res.Height)
+		require.Len(t, res.TxsResults, 1)
+		require.Equal(t, int64(4), res.TxsResults[0].GasUsed)
+
+		_, err = c.BlockResults(ctx, height(2))
+		require.Error(t, err)
+	})
+
+	t.Run("not supported", func(t *testing.T) {
+		_, err := c.Commit(ctx, nil)
+		require.ErrorIs(t, err, errNotSupported)
+
+		_, err = c.Subscribe(ctx, "subscriber", "tm.event='NewBlock'")
+		require.ErrorIs(t, err, errNotSupported)
+
+		_, err = c.ABCIInfo(ctx)
+		require.ErrorIs(t, err, errNoAppState)
+	})
+}
@@ -19,11 +19,13 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/viper"
 
-	"github.com/tendermint/tendermint/libs/strings"
+	tmstrings "github.com/tendermint/tendermint/libs/strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/server/config"
@@ -75,10 +77,56 @@ const (
 
 	// DefaultMaxOpenConnections represents the amount of open connections (unlimited = 0)
 	DefaultMaxOpenConnections = 0
+
+	// DefaultWsSubscriptionBuffer is the default number of events buffered for each websocket log subscription
+	DefaultWsSubscriptionBuffer = 1000
+
+	// DefaultWsOverflowPolicy drops the websocket log subscriptions that can't keep up
+	DefaultWsOverflowPolicy = WsOverflowPolicyDrop
+
+	// DefaultWsMaxConnections represents the amount of websocket connections (unlimited = 0)
+	DefaultWsMaxConnections = 0
+
+	// DefaultWsMaxSubscriptions represents the amount of subscriptions per websocket connection (unlimited = 0)
+	DefaultWsMaxSubscriptions = 0
+
+	// DefaultWsMaxMessageSize represents the max size in bytes of a websocket message (unlimited = 0)
+	DefaultWsMaxMessageSize = 0
+
+	// DefaultWsIdleTimeout represents the idle timeout of websocket connections (disabled = 0)
+	DefaultWsIdleTimeout = 0 * time.Second
+
+	// DefaultRateLimitPerIP represents the request cost allowed per second per client IP (disabled = 0)
+	DefaultRateLimitPerIP float64 = 0
+
+	// DefaultRateLimitPerIPBurst represents the maximum request cost a client IP can spend at once
+	DefaultRateLimitPerIPBurst = 100
+
+	// DefaultRateLimitPerMethod represents the request cost allowed per second per method (disabled = 0)
+	DefaultRateLimitPerMethod float64 = 0
+
+	// DefaultRateLimitPerMethodBurst represents the maximum request cost that can be spent at once on a method
+	DefaultRateLimitPerMethodBurst = 1000
+
+	// DefaultBatchRequestLimit is the default maximum number of requests in a batch, same as geth
+	DefaultBatchRequestLimit = 1000
+
+	// DefaultBatchResponseMaxSize is the default maximum size in bytes of a batch response, same as geth
+	DefaultBatchResponseMaxSize = 25 * 1000 * 1000
+)
+
+const (
+	// WsOverflowPolicyDrop drops a websocket log subscription when its buffer is full
+	WsOverflowPolicyDrop = "drop"
+	// WsOverflowPolicyBlock blocks the event delivery until the subscription buffer has room
+	WsOverflowPolicyBlock = "block"
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
 
+// DefaultRateLimitCosts are the default cost weights of the expensive JSON-RPC methods
+var DefaultRateLimitCosts = []string{"eth_getLogs:10", "eth_estimateGas:5", "debug_trace*:50"}
+
 // Config defines the server's top level configuration. It includes the default app config
 // from the SDK as well as the EVM configuration to enable the JSON-RPC APIs.
 type Config struct {
@@ -138,6 +186,48 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// WsSubscriptionBuffer defines the number of events buffered for each websocket log subscription,
+	// DefaultWsSubscriptionBuffer is used when zero.
+	WsSubscriptionBuffer int `mapstructure:"ws-subscription-buffer"`
+	// WsOverflowPolicy defines what happens when a websocket log subscription buffer is full,
+	// either "drop" the subscription or "block" the event delivery. Defaults to "drop" when empty.
+	WsOverflowPolicy string `mapstructure:"ws-overflow-policy"`
+	// WsAllowedOrigins defines the origins allowed to open websocket connections, all origins are
+	// allowed when empty or containing "*".
+	WsAllowedOrigins []string `mapstructure:"ws-allowed-origins"`
+	// WsMaxConnections sets the maximum number of simultaneous websocket connections (unlimited = 0).
+	WsMaxConnections int `mapstructure:"ws-max-connections"`
+	// WsMaxSubscriptions sets the maximum number of subscriptions per websocket connection (unlimited = 0).
+	WsMaxSubscriptions int `mapstructure:"ws-max-subscriptions"`
+	// WsMaxMessageSize sets the maximum size in bytes of a message read from a websocket connection (unlimited = 0).
+	WsMaxMessageSize int64 `mapstructure:"ws-max-message-size"`
+	// WsIdleTimeout closes the websocket connections that send no message within the timeout (disabled = 0).
+	WsIdleTimeout time.Duration `mapstructure:"ws-idle-timeout"`
+	// WsAuthToken is the bearer token required to open websocket connections.
+	WsAuthToken string `mapstructure:"ws-auth-token"`
+	// WsJWTSecret is the path of the hex encoded JWT secret used to authenticate websocket connections.
+	WsJWTSecret string `mapstructure:"ws-jwt-secret"`
+	// AuthAddress defines the HTTP server serving the non-public namespaces with JWT authentication,
+	// the non-public namespaces are served on the public address when empty.
+	AuthAddress string `mapstructure:"auth-address"`
+	// AuthJWTSecret is the path of the hex encoded JWT secret of the authenticated server.
+	AuthJWTSecret string `mapstructure:"auth-jwt-secret"`
+	// RateLimitPerIP is the request cost allowed per second for each client IP (disabled = 0).
+	RateLimitPerIP float64 `mapstructure:"rate-limit-per-ip"`
+	// RateLimitPerIPBurst is the maximum request cost a client IP can spend at once.
+	RateLimitPerIPBurst int `mapstructure:"rate-limit-per-ip-burst"`
+	// RateLimitPerMethod is the request cost allowed per second for each method, across all
+	// clients (disabled = 0).
+	RateLimitPerMethod float64 `mapstructure:"rate-limit-per-method"`
+	// RateLimitPerMethodBurst is the maximum request cost that can be spent at once on a method.
+	RateLimitPerMethodBurst int `mapstructure:"rate-limit-per-method-burst"`
+	// RateLimitCosts are the cost weights of the methods in the "method:cost" format, a method
+	// ending with "*" matches the methods with the prefix. The other methods cost 1.
+	RateLimitCosts []string `mapstructure:"rate-limit-costs"`
+	// BatchRequestLimit is the maximum number of requests in a batch (unlimited = 0).
+	BatchRequestLimit int `mapstructure:"batch-request-limit"`
+	// BatchResponseMaxSize is the maximum number of bytes returned from a batch call (unlimited = 0).
+	BatchResponseMaxSize int `mapstructure:"batch-response-max-size"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -203,7 +293,7 @@ func DefaultEVMConfig() *EVMConfig {
 
 // Validate returns an error if the tracer type is invalid.
 func (c EVMConfig) Validate() error {
-	if c.Tracer != "" && !strings.StringInSlice(c.Tracer, evmTracers) {
+	if c.Tracer != "" && !tmstrings.StringInSlice(c.Tracer, evmTracers) {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
@@ -241,6 +331,20 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		WsSubscriptionBuffer:     DefaultWsSubscriptionBuffer,
+		WsOverflowPolicy:         DefaultWsOverflowPolicy,
+		WsAllowedOrigins:         []string{"*"},
+		WsMaxConnections:         DefaultWsMaxConnections,
+		WsMaxSubscriptions:       DefaultWsMaxSubscriptions,
+		WsMaxMessageSize:         DefaultWsMaxMessageSize,
+		WsIdleTimeout:            DefaultWsIdleTimeout,
+		RateLimitPerIP:           DefaultRateLimitPerIP,
+		RateLimitPerIPBurst:      DefaultRateLimitPerIPBurst,
+		RateLimitPerMethod:       DefaultRateLimitPerMethod,
+		RateLimitPerMethodBurst:  DefaultRateLimitPerMethodBurst,
+		RateLimitCosts:           DefaultRateLimitCosts,
+		BatchRequestLimit:        DefaultBatchRequestLimit,
+		BatchResponseMaxSize:     DefaultBatchResponseMaxSize,
 	}
 }
 
@@ -282,6 +386,69 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.WsSubscriptionBuffer < 0 {
+		return errors.New("JSON-RPC websocket subscription buffer cannot be negative")
+	}
+
+	if c.WsMaxConnections < 0 {
+		return errors.New("JSON-RPC websocket max connections cannot be negative")
+	}
+
+	if c.WsMaxSubscriptions < 0 {
+		return errors.New("JSON-RPC websocket max subscriptions cannot be negative")
+	}
+
+	if c.WsMaxMessageSize < 0 {
+		return errors.New("JSON-RPC websocket max message size cannot be negative")
+	}
+
+	if c.WsIdleTimeout < 0 {
+		return errors.New("JSON-RPC websocket idle timeout duration cannot be negative")
+	}
+
+	if c.AuthAddress != "" && c.AuthJWTSecret == "" {
+		return errors.New("JSON-RPC auth-jwt-secret must be set when auth-address is enabled")
+	}
+
+	if c.BatchRequestLimit < 0 {
+		return errors.New("JSON-RPC batch request limit cannot be negative")
+	}
+
+	if c.BatchResponseMaxSize < 0 {
+		return errors.New("JSON-RPC batch response max size cannot be negative")
+	}
+
+	if c.RateLimitPerIP < 0 || c.RateLimitPerMethod < 0 {
+		return errors.New("JSON-RPC rate limits cannot be negative")
+	}
+
+	costs, err := c.RateLimitMethodCosts()
+	if err != nil {
+		return err
+	}
+
+	maxCost := 1
+	for _, cost := range costs {
+		if cost > maxCost {
+			maxCost = cost
+		}
+	}
+
+	if c.RateLimitPerIP > 0 && c.RateLimitPerIPBurst < maxCost {
+		return fmt.Errorf("JSON-RPC rate-limit-per-ip-burst cannot be lower than the max method cost %d", maxCost)
+	}
+
+	if c.RateLimitPerMethod > 0 && c.RateLimitPerMethodBurst < maxCost {
+		return fmt.Errorf("JSON-RPC rate-limit-per-method-burst cannot be lower than the max method cost %d", maxCost)
+	}
+
+	switch c.WsOverflowPolicy {
+	case "", WsOverflowPolicyDrop, WsOverflowPolicyBlock:
+	default:
+		return fmt.Errorf("invalid JSON-RPC websocket overflow policy '%s', expected '%s' or '%s'",
+			c.WsOverflowPolicy, WsOverflowPolicyDrop, WsOverflowPolicyBlock)
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -295,6 +462,25 @@ func (c JSONRPCConfig) Validate() error {
 	return nil
 }
 
+// RateLimitMethodCosts parses the cost weights of the rate limited methods.
+func (c JSONRPCConfig) RateLimitMethodCosts() (map[string]int, error) {
+	costs := make(map[string]int, len(c.RateLimitCosts))
+	for _, entry := range c.RateLimitCosts {
+		idx := strings.LastIndex(entry, ":")
+		if idx <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC method cost '%s', expected method:cost", entry)
+		}
+
+		cost, err := strconv.Atoi(strings.TrimSpace(entry[idx+1:]))
+		if err != nil || cost <= 0 {
+			return nil, fmt.Errorf("invalid JSON-RPC method cost '%s', cost must be a positive integer", entry)
+		}
+
+		costs[strings.TrimSpace(entry[:idx])] = cost
+	}
+	return costs, nil
+}
+
 // DefaultTLSConfig returns the default TLS configuration
 func DefaultTLSConfig() *TLSConfig {
 	return &TLSConfig{
@@ -351,6 +537,24 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			EnableIndexer:            v.GetBool("json-rpc.enable-indexer"),
 			MetricsAddress:           v.GetString("json-rpc.metrics-address"),
 			FixRevertGasRefundHeight: v.GetInt64("json-rpc.fix-revert-gas-refund-height"),
+			WsSubscriptionBuffer:     v.GetInt("json-rpc.ws-subscription-buffer"),
+			WsOverflowPolicy:         v.GetString("json-rpc.ws-overflow-policy"),
+			WsAllowedOrigins:         v.GetStringSlice("json-rpc.ws-allowed-origins"),
+			WsMaxConnections:         v.GetInt("json-rpc.ws-max-connections"),
+			WsMaxSubscriptions:       v.GetInt("json-rpc.ws-max-subscriptions"),
+			WsMaxMessageSize:         v.GetInt64("json-rpc.ws-max-message-size"),
+			WsIdleTimeout:            v.GetDuration("json-rpc.ws-idle-timeout"),
+			WsAuthToken:              v.GetString("json-rpc.ws-auth-token"),
+			WsJWTSecret:              v.GetString("json-rpc.ws-jwt-secret"),
+			AuthAddress:              v.GetString("json-rpc.auth-address"),
+			AuthJWTSecret:            v.GetString("json-rpc.auth-jwt-secret"),
+			RateLimitPerIP:           v.GetFloat64("json-rpc.rate-limit-per-ip"),
+			RateLimitPerIPBurst:      v.GetInt("json-rpc.rate-limit-per-ip-burst"),
+			RateLimitPerMethod:       v.GetFloat64("json-rpc.rate-limit-per-method"),
+			RateLimitPerMethodBurst:  v.GetInt("json-rpc.rate-limit-per-method-burst"),
+			RateLimitCosts:           v.GetStringSlice("json-rpc.rate-limit-costs"),
+			BatchRequestLimit:        v.GetInt("json-rpc.batch-request-limit"),
+			BatchResponseMaxSize:     v.GetInt("json-rpc.batch-response-max-size"),
 		},
 		TLS: TLSConfig{
 			CertificatePath: v.GetString("tls.certificate-path"),
@@ -2,6 +2,7 @@ package config
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -12,3 +13,53 @@ func TestDefaultConfig(t *testing.T) {
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
 }
+
+func TestJSONRPCConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		malleate func(cfg *JSONRPCConfig)
+		expPass  bool
+	}{
+		{"default", func(*JSONRPCConfig) {}, true},
+		{"unset values", func(cfg *JSONRPCConfig) {
+			cfg.WsSubscriptionBuffer = 0
+			cfg.WsOverflowPolicy = ""
+		}, true},
+		{"block policy", func(cfg *JSONRPCConfig) { cfg.WsOverflowPolicy = WsOverflowPolicyBlock }, true},
+		{"negative buffer", func(cfg *JSONRPCConfig) { cfg.WsSubscriptionBuffer = -1 }, false},
+		{"invalid policy", func(cfg *JSONRPCConfig) { cfg.WsOverflowPolicy = "ignore" }, false},
+		{"negative max connections", func(cfg *JSONRPCConfig) { cfg.WsMaxConnections = -1 }, false},
+		{"negative max subscriptions", func(cfg *JSONRPCConfig) { cfg.WsMaxSubscriptions = -1 }, false},
+		{"negative max message size", func(cfg *JSONRPCConfig) { cfg.WsMaxMessageSize = -1 }, false},
+		{"negative idle timeout", func(cfg *JSONRPCConfig) { cfg.WsIdleTimeout = -time.Second }, false},
+		{"auth address with jwt secret", func(cfg *JSONRPCConfig) {
+			cfg.AuthAddress = "127.0.0.1:8551"
+			cfg.AuthJWTSecret = "jwt.hex"
+		}, true},
+		{"auth address without jwt secret", func(cfg *JSONRPCConfig) { cfg.AuthAddress = "127.0.0.1:8551" }, false},
+		{"rate limits", func(cfg *JSONRPCConfig) {
+			cfg.RateLimitPerIP = 10
+			cfg.RateLimitPerMethod = 100
+		}, true},
+		{"negative rate limit", func(cfg *JSONRPCConfig) { cfg.RateLimitPerIP = -1 }, false},
+		{"burst lower than cost", func(cfg *JSONRPCConfig) {
+			cfg.RateLimitPerIP = 10
+			cfg.RateLimitPerIPBurst = 5
+		}, false},
+		{"invalid method cost", func(cfg *JSONRPCConfig) { cfg.RateLimitCosts = []string{"eth_getLogs"} }, false},
+		{"zero method cost", func(cfg *JSONRPCConfig) { cfg.RateLimitCosts = []string{"eth_getLogs:0"} }, false},
+		{"negative batch request limit", func(cfg *JSONRPCConfig) { cfg.BatchRequestLimit = -1 }, false},
+		{"negative batch response max size", func(cfg *JSONRPCConfig) { cfg.BatchResponseMaxSize = -1 }, false},
+	}
+
+	for _, tc := range testCases {
+		cfg := DefaultJSONRPCConfig()
+		tc.malleate(cfg)
+		err := cfg.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -95,6 +95,68 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# WsSubscriptionBuffer defines the number of events buffered for each websocket log subscription.
+ws-subscription-buffer = {{ .JSONRPC.WsSubscriptionBuffer }}
+
+# WsOverflowPolicy defines what happens when a websocket log subscription buffer is full:
+# "drop" closes the lagging subscription with an error notification, "block" delays the event
+# delivery to all subscribers until the subscription catches up.
+ws-overflow-policy = "{{ .JSONRPC.WsOverflowPolicy }}"
+
+# WsAllowedOrigins defines the origins allowed to open websocket connections, "*" allows all origins.
+ws-allowed-origins = [{{range $index, $elmt := .JSONRPC.WsAllowedOrigins}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# WsMaxConnections sets the maximum number of simultaneous websocket connections (unlimited = 0).
+ws-max-connections = {{ .JSONRPC.WsMaxConnections }}
+
+# WsMaxSubscriptions sets the maximum number of subscriptions per websocket connection (unlimited = 0).
+ws-max-subscriptions = {{ .JSONRPC.WsMaxSubscriptions }}
+
+# WsMaxMessageSize sets the maximum size in bytes of a message read from a websocket connection (unlimited = 0).
+ws-max-message-size = {{ .JSONRPC.WsMaxMessageSize }}
+
+# WsIdleTimeout closes the websocket connections that send no message within the timeout (disabled = 0s).
+ws-idle-timeout = "{{ .JSONRPC.WsIdleTimeout }}"
+
+# WsAuthToken is the bearer token required in the Authorization header to open websocket connections.
+ws-auth-token = "{{ .JSONRPC.WsAuthToken }}"
+
+# WsJWTSecret is the path of the hex encoded 32 bytes JWT secret, when set the websocket connections
+# can be authenticated with a HS256 JWT bearer token, like geth authrpc.
+ws-jwt-secret = "{{ .JSONRPC.WsJWTSecret }}"
+
+# AuthAddress defines the HTTP server address serving the non-public namespaces (personal, debug,
+# miner) with JWT authentication, like geth authrpc. When empty, they are served on the public address.
+auth-address = "{{ .JSONRPC.AuthAddress }}"
+
+# AuthJWTSecret is the path of the hex encoded 32 bytes JWT secret of the authenticated server.
+auth-jwt-secret = "{{ .JSONRPC.AuthJWTSecret }}"
+
+# RateLimitPerIP is the request cost allowed per second for each client IP (disabled = 0).
+# Rate limited requests get the -32005 "limit exceeded" error.
+rate-limit-per-ip = {{ .JSONRPC.RateLimitPerIP }}
+
+# RateLimitPerIPBurst is the maximum request cost a client IP can spend at once.
+rate-limit-per-ip-burst = {{ .JSONRPC.RateLimitPerIPBurst }}
+
+# RateLimitPerMethod is the request cost allowed per second for each method across all clients (disabled = 0).
+rate-limit-per-method = {{ .JSONRPC.RateLimitPerMethod }}
+
+# RateLimitPerMethodBurst is the maximum request cost that can be spent at once on a method.
+rate-limit-per-method-burst = {{ .JSONRPC.RateLimitPerMethodBurst }}
+
+# RateLimitCosts are the cost weights of the methods in the "method:cost" format, a method ending
+# with "*" matches the methods with the prefix. The other methods cost 1.
+rate-limit-costs = [{{range $index, $elmt := .JSONRPC.RateLimitCosts}}{{if $index}}, {{end}}"{{$elmt}}"{{end}}]
+
+# BatchRequestLimit is the maximum number of requests in a batch, on both the HTTP and the
+# websocket servers (unlimited = 0).
+batch-request-limit = {{ .JSONRPC.BatchRequestLimit }}
+
+# BatchResponseMaxSize is the maximum number of bytes returned from a batch call, the requests
+# after the limit get a "response too large" error (unlimited = 0).
+batch-response-max-size = {{ .JSONRPC.BatchResponseMaxSize }}
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -65,6 +65,10 @@ const (
 	JSONRPCAllowUnprotectedTxs = "json-rpc.allow-unprotected-txs"
 	JSONRPCMaxOpenConnections  = "json-rpc.max-open-connections"
 	JSONRPCEnableIndexer       = "json-rpc.enable-indexer"
+	JSONRPCAuthAddress         = "json-rpc.auth-address"
+	JSONRPCAuthJWTSecret       = "json-rpc.auth-jwt-secret"
+	// JSONRPCOnly serves the historical data from the local databases without starting the node.
+	JSONRPCOnly = "json-rpc-only"
 	// JSONRPCEnableMetrics enables EVM RPC metrics server.
 	// Set to `metrics` which is hardcoded flag from go-ethereum.
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
@@ -17,20 +17,23 @@ package server
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
 	"github.com/evmos/ethermint/indexer"
+	abci "github.com/tendermint/tendermint/abci/types"
 	tmnode "github.com/tendermint/tendermint/node"
 	sm "github.com/tendermint/tendermint/state"
 	tmstore "github.com/tendermint/tendermint/store"
+	tmtypes "github.com/tendermint/tendermint/types"
 )
 
 func NewIndexTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "index-eth-tx [backward|forward]",
+		Use:   "index-eth-tx [backward|forward|verify|rollback <height>|range <from> <to>]",
 		Short: "Index historical eth txs",
 		Long: `Index historical eth txs, it only support two traverse direction to avoid creating gaps in the indexer db if using arbitrary block ranges:
 		- backward: index the blocks from the first indexed block to the earliest block in the chain, if indexer db is empty, start from the latest block.
@@ -38,8 +41,13 @@ func NewIndexTxCmd() *cobra.Command {
 
 		When start the node, the indexer start from the latest indexed block to avoid creating gap.
         Backward mode should be used most of the time, so the latest indexed block is always up-to-date.
+
+		Some commands are provided to repair the indexer db, e.g. after a node rollback or a crash:
+		- verify: check the indexed blocks against the local blockstore, report the gaps, mismatches and the entries of blocks that don't exist.
+		- rollback <height>: delete the entries of the blocks higher than the height.
+		- range <from> <to>: re-index the blocks in the range (inclusive), the existing entries of these blocks are replaced.
 		`,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.RangeArgs(1, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			serverCtx := server.GetServerContextFromCmd(cmd)
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -47,9 +55,29 @@ func NewIndexTxCmd() *cobra.Command {
 				return err
 			}
 
-			direction := args[0]
-			if direction != "backward" && direction != "forward" {
-				return fmt.Errorf("unknown index direction, expect: backward|forward, got: %s", direction)
+			var heights []int64
+			switch args[0] {
+			case "backward", "forward", "verify":
+				if len(args) != 1 {
+					return fmt.Errorf("%s command expects no argument, got: %d", args[0], len(args)-1)
+				}
+			case "rollback":
+				if len(args) != 2 {
+					return fmt.Errorf("rollback command expects 1 argument: <height>")
+				}
+			case "range":
+				if len(args) != 3 {
+					return fmt.Errorf("range command expects 2 arguments: <from> <to>")
+				}
+			default:
+				return fmt.Errorf("unknown index command, expect: backward|forward|verify|rollback|range, got: %s", args[0])
+			}
+			for _, arg := range args[1:] {
+				height, err := strconv.ParseInt(arg, 10, 64)
+				if err != nil || height < 0 {
+					return fmt.Errorf("invalid block height: %s", arg)
+				}
+				heights = append(heights, height)
 			}
 
 			cfg := serverCtx.Config
@@ -62,6 +90,16 @@ func NewIndexTxCmd() *cobra.Command {
 			}
 			idxer := indexer.NewKVIndexer(idxDB, logger.With("module", "evmindex"), clientCtx)
 
+			if args[0] == "rollback" {
+				// the blockstore is not needed, it might be rolled back already.
+				deleted, err := idxer.PruneBlocksAbove(heights[0])
+				if err != nil {
+					return err
+				}
+				fmt.Printf("deleted %d eth txs above height %d\n", deleted, heights[0])
+				return nil
+			}
+
 			// open local tendermint db, because the local rpc won't be available.
 			tmdb, err := tmnode.DefaultDBProvider(&tmnode.DBContext{ID: "blockstore", Config: cfg})
 			if err != nil {
@@ -77,16 +115,24 @@ func NewIndexTxCmd() *cobra.Command {
 				DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
 			})
 
-			indexBlock := func(height int64) error {
+			loadBlock := func(height int64) (*tmtypes.Block, []*abci.ResponseDeliverTx, error) {
 				blk := blockStore.LoadBlock(height)
 				if blk == nil {
-					return fmt.Errorf("block not found %d", height)
+					return nil, nil, fmt.Errorf("block not found %d", height)
 				}
 				resBlk, err := stateStore.LoadABCIResponses(height)
+				if err != nil {
+					return nil, nil, err
+				}
+				return blk, resBlk.DeliverTxs, nil
+			}
+
+			indexBlock := func(height int64) error {
+				blk, txResults, err := loadBlock(height)
 				if err != nil {
 					return err
 				}
-				if err := idxer.IndexBlock(blk, resBlk.DeliverTxs); err != nil {
+				if err := idxer.IndexBlock(blk, txResults); err != nil {
 					return err
 				}
 				fmt.Println(height)
@@ -122,6 +168,62 @@ func NewIndexTxCmd() *cobra.Command {
 						return err
 					}
 				}
+			case "verify":
+				first, err := idxer.FirstIndexedBlock()
+				if err != nil {
+					return err
+				}
+				last, err := idxer.LastIndexedBlock()
+				if err != nil {
+					return err
+				}
+				if first == -1 {
+					fmt.Println("indexer db is empty")
+					return nil
+				}
+
+				var issues int
+				if last > blockStore.Height() {
+					fmt.Printf("found entries above the latest block %d, last indexed block: %d, use rollback command to remove them\n", blockStore.Height(), last)
+					issues++
+					last = blockStore.Height()
+				}
+				if first < blockStore.Base() {
+					fmt.Printf("blocks below %d are pruned from the blockstore, skip verifying them\n", blockStore.Base())
+					first = blockStore.Base()
+				}
+				for i := first; i <= last; i++ {
+					blk, txResults, err := loadBlock(i)
+					if err != nil {
+						return err
+					}
+					if err := idxer.VerifyBlock(blk, txResults); err != nil {
+						fmt.Println(err.Error())
+						issues++
+					}
+				}
+				if issues > 0 {
+					return fmt.Errorf("found %d issues in indexer db, use range command to re-index the blocks", issues)
+				}
+				fmt.Printf("verified blocks from %d to %d\n", first, last)
+			case "range":
+				from, to := heights[0], heights[1]
+				if from > to {
+					return fmt.Errorf("invalid block range, from %d is greater than to %d", from, to)
+				}
+				if from < blockStore.Base() || to > blockStore.Height() {
+					return fmt.Errorf("block range [%d, %d] out of the blockstore range [%d, %d]", from, to, blockStore.Base(), blockStore.Height())
+				}
+				for i := from; i <= to; i++ {
+					blk, txResults, err := loadBlock(i)
+					if err != nil {
+						return err
+					}
+					if err := idxer.ReindexBlock(blk, txResults); err != nil {
+						return err
+					}
+					fmt.Println(i)
+				}
 			default:
 				return fmt.Errorf("unknown direction %s", args[0])
 			}
@@ -16,6 +16,7 @@
 package server
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	ethlog "github.com/ethereum/go-ethereum/log"
 	ethrpc "github.com/ethereum/go-ethereum/rpc"
 	"github.com/evmos/ethermint/rpc"
+	"github.com/evmos/ethermint/rpc/backend"
 
 	"github.com/evmos/ethermint/server/config"
 	ethermint "github.com/evmos/ethermint/types"
@@ -43,6 +45,87 @@ func StartJSONRPC(ctx *server.Context,
 ) (*http.Server, chan struct{}, error) {
 	tmWsClient := ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
 
+	setupGethLogger(ctx)
+
+	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
+	rpcAPIArr := config.JSONRPC.API
+
+	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+
+	var authAPIs []ethrpc.API
+	if config.JSONRPC.AuthAddress != "" {
+		apis, authAPIs = splitPublicAPIs(apis)
+	} else {
+		for _, api := range apis {
+			if !api.Public {
+				ctx.Logger.Info("serving non-public JSON-RPC namespace on the public address, set json-rpc.auth-address to authenticate it", "namespace", api.Namespace)
+			}
+		}
+	}
+
+	// allocate separate WS connection to Tendermint
+	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
+	evmBackend := backend.NewBackend(ctx, ctx.Logger, clientCtx, allowUnprotectedTxs, indexer)
+	wsSrv, err := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, evmBackend, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var authSrv *http.Server
+	if config.JSONRPC.AuthAddress != "" {
+		jwtSecret, err := rpc.ReadJWTSecret(config.JSONRPC.AuthJWTSecret)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if authSrv, _, err = startHTTPServer(ctx, config, config.JSONRPC.AuthAddress, authAPIs, jwtSecret); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	httpSrv, httpSrvDone, err := startHTTPServer(ctx, config, config.JSONRPC.Address, apis, nil)
+	if err != nil {
+		if authSrv != nil {
+			_ = authSrv.Close()
+		}
+		return nil, nil, err
+	}
+
+	if authSrv != nil {
+		// the authenticated server is shut down along with the public one
+		httpSrv.RegisterOnShutdown(func() {
+			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancelFn()
+			if err := authSrv.Shutdown(shutdownCtx); err != nil {
+				ctx.Logger.Error("failed to shutdown authenticated JSON-RPC server", "error", err.Error())
+			}
+		})
+	}
+
+	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
+	wsSrv.Start()
+	return httpSrv, httpSrvDone, nil
+}
+
+// StartHistoricalJSONRPC starts the JSON-RPC server in json-rpc only mode, it only serves the
+// historical data with the client context connected to the local databases, the websocket
+// server is not started since there are no new events.
+func StartHistoricalJSONRPC(ctx *server.Context,
+	clientCtx client.Context,
+	config *config.Config,
+	indexer ethermint.EVMTxIndexer,
+) (*http.Server, chan struct{}, error) {
+	setupGethLogger(ctx)
+
+	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
+	rpcAPIArr := config.JSONRPC.API
+
+	apis := rpc.GetHistoricalRPCAPIs(ctx, clientCtx, allowUnprotectedTxs, indexer, rpcAPIArr)
+	return startHTTPServer(ctx, config, config.JSONRPC.Address, apis, nil)
+}
+
+// setupGethLogger redirects the go-ethereum logs to the server logger.
+func setupGethLogger(ctx *server.Context) {
 	logger := ctx.Logger.With("module", "geth")
 	ethlog.Root().SetHandler(ethlog.FuncHandler(func(r *ethlog.Record) error {
 		switch r.Lvl {
@@ -55,13 +138,31 @@ func StartJSONRPC(ctx *server.Context,
 		}
 		return nil
 	}))
+}
 
-	rpcServer := ethrpc.NewServer()
-
-	allowUnprotectedTxs := config.JSONRPC.AllowUnprotectedTxs
-	rpcAPIArr := config.JSONRPC.API
+// splitPublicAPIs splits the apis into the public ones and the ones requiring authentication.
+func splitPublicAPIs(apis []ethrpc.API) (public, private []ethrpc.API) {
+	for _, api := range apis {
+		if api.Public {
+			public = append(public, api)
+		} else {
+			private = append(private, api)
+		}
+	}
+	return public, private
+}
 
-	apis := rpc.GetRPCAPIs(ctx, clientCtx, tmWsClient, allowUnprotectedTxs, indexer, rpcAPIArr)
+// startHTTPServer registers the apis and starts the JSON-RPC http server listening on the address,
+// the requests must be authenticated with a JWT signed with the secret if it's not empty, otherwise
+// the configured rate limits are applied. The batch limits apply to all the servers.
+func startHTTPServer(
+	ctx *server.Context,
+	config *config.Config,
+	address string,
+	apis []ethrpc.API,
+	jwtSecret []byte,
+) (*http.Server, chan struct{}, error) {
+	rpcServer := ethrpc.NewServer()
 
 	for _, api := range apis {
 		if err := rpcServer.RegisterName(api.Namespace, api.Service); err != nil {
@@ -74,17 +175,44 @@ func StartJSONRPC(ctx *server.Context,
 		}
 	}
 
+	var rpcHandler http.Handler = rpcServer
+	if len(jwtSecret) == 0 && (config.JSONRPC.RateLimitPerIP > 0 || config.JSONRPC.RateLimitPerMethod > 0) {
+		costs, err := config.JSONRPC.RateLimitMethodCosts()
+		if err != nil {
+			return nil, nil, err
+		}
+
+		rpcHandler = newRateLimiter(
+			ctx.Logger.With("module", "rate-limiter"),
+			rpcServer,
+			config.JSONRPC.RateLimitPerIP,
+			config.JSONRPC.RateLimitPerIPBurst,
+			config.JSONRPC.RateLimitPerMethod,
+			config.JSONRPC.RateLimitPerMethodBurst,
+			costs,
+		)
+	}
+
+	if config.JSONRPC.BatchRequestLimit > 0 || config.JSONRPC.BatchResponseMaxSize > 0 {
+		rpcHandler = newBatchLimiter(rpcHandler, config.JSONRPC.BatchRequestLimit, config.JSONRPC.BatchResponseMaxSize)
+	}
+
 	r := mux.NewRouter()
-	r.HandleFunc("/", rpcServer.ServeHTTP).Methods("POST")
+	r.Handle("/", rpcHandler).Methods("POST")
 
 	handlerWithCors := cors.Default()
 	if config.API.EnableUnsafeCORS {
 		handlerWithCors = cors.AllowAll()
 	}
 
+	handler := handlerWithCors.Handler(r)
+	if len(jwtSecret) > 0 {
+		handler = rpc.NewJWTHandler(jwtSecret, handler)
+	}
+
 	httpSrv := &http.Server{
-		Addr:              config.JSONRPC.Address,
-		Handler:           handlerWithCors.Handler(r),
+		Addr:              address,
+		Handler:           handler,
 		ReadHeaderTimeout: config.JSONRPC.HTTPTimeout,
 		ReadTimeout:       config.JSONRPC.HTTPTimeout,
 		WriteTimeout:      config.JSONRPC.HTTPTimeout,
@@ -99,7 +227,7 @@ func StartJSONRPC(ctx *server.Context,
 
 	errCh := make(chan error)
 	go func() {
-		ctx.Logger.Info("Starting JSON-RPC server", "address", config.JSONRPC.Address)
+		ctx.Logger.Info("Starting JSON-RPC server", "address", address, "authenticated", len(jwtSecret) > 0)
 		if err := httpSrv.Serve(ln); err != nil {
 			if err == http.ErrServerClosed {
 				close(httpSrvDone)
@@ -118,11 +246,5 @@ func StartJSONRPC(ctx *server.Context,
 	case <-time.After(types.ServerStartTime): // assume JSON RPC server started successfully
 	}
 
-	ctx.Logger.Info("Starting JSON WebSocket server", "address", config.JSONRPC.WsAddress)
-
-	// allocate separate WS connection to Tendermint
-	tmWsClient = ConnectTmWS(tmRPCAddr, tmEndpoint, ctx.Logger)
-	wsSrv := rpc.NewWebsocketsServer(clientCtx, ctx.Logger, tmWsClient, config)
-	wsSrv.Start()
 	return httpSrv, httpSrvDone, nil
 }
@@ -0,0 +1,337 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/tendermint/tendermint/libs/log"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// errCodeLimitExceeded is the standard JSON-RPC error code of the rate limited requests
+	errCodeLimitExceeded = -32005
+
+	// maxRequestContentLength is the maximum size of the request body read by the middlewares, same
+	// as the geth rpc server limit
+	maxRequestContentLength = 1024 * 1024 * 5
+
+	// limiterCleanupInterval is the interval at which the idle per-IP limiters are released
+	limiterCleanupInterval = time.Minute
+	// limiterIdleTimeout is the time after which a per-IP limiter that hasn't been used is released
+	limiterIdleTimeout = 3 * time.Minute
+
+	// maxMethodLimiters bounds the number of per-method limiters, the methods seen after the bound
+	// is reached share the otherMethods limiter
+	maxMethodLimiters = 512
+	otherMethods      = "other"
+)
+
+// rpcRequest is the part of a JSON-RPC request used by the rate limiter.
+type rpcRequest struct {
+	ID     json.RawMessage `json:"id"`
+	Method string          `json:"method"`
+}
+
+type ipLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// rateLimiter is a http middleware applying token bucket limits to the JSON-RPC requests per client
+// IP and per method, each request consumes the cost weight of its method from both buckets.
+type rateLimiter struct {
+	logger log.Logger
+	next   http.Handler
+
+	ipRate      rate.Limit
+	ipBurst     int
+	methodRate  rate.Limit
+	methodBurst int
+	costs       map[string]int
+
+	mux         sync.Mutex
+	ipLimiters  map[string]*ipLimiter
+	methods     map[string]*rate.Limiter
+	lastCleanup time.Time
+}
+
+// newRateLimiter wraps the handler with the rate limits, a zero rate disables the corresponding limit.
+func newRateLimiter(
+	logger log.Logger,
+	next http.Handler,
+	ipRate float64,
+	ipBurst int,
+	methodRate float64,
+	methodBurst int,
+	costs map[string]int,
+) *rateLimiter {
+	return &rateLimiter{
+		logger:      logger,
+		next:        next,
+		ipRate:      rate.Limit(ipRate),
+		ipBurst:     ipBurst,
+		methodRate:  rate.Limit(methodRate),
+		methodBurst: methodBurst,
+		costs:       costs,
+		ipLimiters:  make(map[string]*ipLimiter),
+		methods:     make(map[string]*rate.Limiter),
+		lastCleanup: time.Now(),
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (rl *rateLimiter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, ok := readBody(w, r)
+	if !ok {
+		return
+	}
+
+	reqs, batch := parseRPCRequests(body)
+	if len(reqs) == 0 {
+		// let the rpc server answer the malformed requests
+		rl.next.ServeHTTP(w, r)
+		return
+	}
+
+	methodCosts := make(map[string]int)
+	total := 0
+	for _, req := range reqs {
+		cost := rl.cost(req.Method)
+		methodCosts[req.Method] += cost
+		total += cost
+	}
+
+	// the tokens are reserved from all the limiters first, and given back if any of them rejects the
+	// request, so that a rejected request doesn't consume the budget of the others
+	now := time.Now()
+	var reservations []*rate.Reservation
+	cancel := func() {
+		for _, res := range reservations {
+			res.CancelAt(now)
+		}
+	}
+
+	if res := rl.reserveIP(clientIP(r), total, now); res != nil {
+		reservations = append(reservations, res)
+		if !allowed(res, now) {
+			cancel()
+			metrics.GetOrRegisterCounter("rpc/ratelimit/ip/rejected", nil).Inc(1)
+			rl.logger.Debug("JSON-RPC request rate limited", "ip", clientIP(r), "cost", total)
+			writeLimitExceeded(w, reqs, batch, "rate limit exceeded")
+			return
+		}
+	}
+
+	for method, cost := range methodCosts {
+		res, key := rl.reserveMethod(method, cost, now)
+		if res == nil {
+			continue
+		}
+		reservations = append(reservations, res)
+		if !allowed(res, now) {
+			cancel()
+			metrics.GetOrRegisterCounter("rpc/ratelimit/method/"+key+"/rejected", nil).Inc(1)
+			rl.logger.Debug("JSON-RPC method rate limited", "method", method, "cost", cost)
+			writeLimitExceeded(w, reqs, batch, "rate limit exceeded for method "+method)
+			return
+		}
+	}
+
+	metrics.GetOrRegisterCounter("rpc/ratelimit/cost", nil).Inc(int64(total))
+	rl.next.ServeHTTP(w, r)
+}
+
+// cost returns the cost weight of the method, an exact match takes precedence over the longest
+// matching prefix, the default cost is 1.
+func (rl *rateLimiter) cost(method string) int {
+	if cost, ok := rl.costs[method]; ok {
+		return cost
+	}
+
+	cost, matched := 1, 0
+	for pattern, c := range rl.costs {
+		prefix := strings.TrimSuffix(pattern, "*")
+		if prefix != pattern && strings.HasPrefix(method, prefix) && len(prefix) >= matched {
+			cost, matched = c, len(prefix)
+		}
+	}
+	return cost
+}
+
+// reserveIP reserves the cost from the client IP limiter, it returns nil if the limit is disabled.
+func (rl *rateLimiter) reserveIP(ip string, cost int, now time.Time) *rate.Reservation {
+	if rl.ipRate <= 0 {
+		return nil
+	}
+
+	rl.mux.Lock()
+	defer rl.mux.Unlock()
+
+	if now.Sub(rl.lastCleanup) > limiterCleanupInterval {
+		for key, l := range rl.ipLimiters {
+			if now.Sub(l.lastSeen) > limiterIdleTimeout {
+				delete(rl.ipLimiters, key)
+			}
+		}
+		rl.lastCleanup = now
+	}
+
+	l, ok := rl.ipLimiters[ip]
+	if !ok {
+		l = &ipLimiter{limiter: rate.NewLimiter(rl.ipRate, rl.ipBurst)}
+		rl.ipLimiters[ip] = l
+	}
+	l.lastSeen = now
+
+	return l.limiter.ReserveN(now, cost)
+}
+
+// reserveMethod reserves the cost from the method limiter, it returns the key of the limiter used,
+// and a nil reservation if the limit is disabled.
+func (rl *rateLimiter) reserveMethod(method string, cost int, now time.Time) (*rate.Reservation, string) {
+	if rl.methodRate <= 0 {
+		return nil, method
+	}
+
+	rl.mux.Lock()
+	key := method
+	l, ok := rl.methods[key]
+	if !ok && len(rl.methods) >= maxMethodLimiters {
+		key = otherMethods
+		l, ok = rl.methods[key]
+	}
+	if !ok {
+		l = rate.NewLimiter(rl.methodRate, rl.methodBurst)
+		rl.methods[key] = l
+	}
+	rl.mux.Unlock()
+
+	return l.ReserveN(now, cost), key
+}
+
+// allowed returns true if the reserved tokens are available now, same as rate.Limiter.AllowN.
+func allowed(res *rate.Reservation, now time.Time) bool {
+	return res.OK() && res.DelayFrom(now) == 0
+}
+
+// readBody reads the request body up to maxRequestContentLength and replaces it with a reader of
+// the read bytes for the next handler. The errors are answered to the client, with the 413 status
+// for the oversized bodies, and false is returned.
+func readBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestContentLength))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		} else {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+		return nil, false
+	}
+
+	r.Body = io.NopCloser(bytes.NewReader(body))
+	return body, true
+}
+
+// parseRPCRequests parses a single or a batch JSON-RPC request, it returns no requests if the body
+// is malformed.
+func parseRPCRequests(body []byte) ([]rpcRequest, bool) {
+	body = bytes.TrimLeft(body, " \t\r\n")
+	if len(body) > 0 && body[0] == '[' {
+		var reqs []rpcRequest
+		if err := json.Unmarshal(body, &reqs); err != nil {
+			return nil, true
+		}
+		return reqs, true
+	}
+
+	var req rpcRequest
+	if err := json.Unmarshal(body, &req); err != nil {
+		return nil, false
+	}
+	return []rpcRequest{req}, false
+}
+
+// clientIP returns the IP of the client. The X-Forwarded-For header is only trusted from the
+// loopback address, where the websocket server forwards the requests from. Its rightmost entry is
+// used, as it's the one added by the local proxy, while the ones on its left are set by the client.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		if values := r.Header.Values("X-Forwarded-For"); len(values) > 0 {
+			entries := strings.Split(values[len(values)-1], ",")
+			if forwarded := strings.TrimSpace(entries[len(entries)-1]); forwarded != "" {
+				return forwarded
+			}
+		}
+	}
+
+	return host
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// rpcErrorResponse is a JSON-RPC error response written by the middlewares.
+type rpcErrorResponse struct {
+	Jsonrpc string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   rpcError        `json:"error"`
+}
+
+func newRPCErrorResponse(id json.RawMessage, code int, msg string) rpcErrorResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return rpcErrorResponse{
+		Jsonrpc: "2.0",
+		ID:      id,
+		Error:   rpcError{Code: code, Message: msg},
+	}
+}
+
+// writeLimitExceeded answers all the requests with the limit exceeded error.
+func writeLimitExceeded(w http.ResponseWriter, reqs []rpcRequest, batch bool, msg string) {
+	resps := make([]rpcErrorResponse, len(reqs))
+	for i, req := range reqs {
+		resps[i] = newRPCErrorResponse(req.ID, errCodeLimitExceeded, msg)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	if batch {
+		_ = json.NewEncoder(w).Encode(resps)
+	} else {
+		_ = json.NewEncoder(w).Encode(resps[0])
+	}
+}
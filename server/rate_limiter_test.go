@@ -0,0 +1,120 @@
+package server
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestRateLimiter(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	costs := map[string]int{"eth_getLogs": 10, "debug_trace*": 50, "debug_traceCall": 20}
+
+	call := func(rl *rateLimiter, remoteAddr, forwarded, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		r.RemoteAddr = remoteAddr
+		if forwarded != "" {
+			r.Header.Set("X-Forwarded-For", forwarded)
+		}
+		w := httptest.NewRecorder()
+		rl.ServeHTTP(w, r)
+		return w
+	}
+
+	t.Run("cost", func(t *testing.T) {
+		rl := newRateLimiter(log.NewNopLogger(), next, 1, 10, 0, 0, costs)
+		require.Equal(t, 1, rl.cost("eth_blockNumber"))
+		require.Equal(t, 10, rl.cost("eth_getLogs"))
+		require.Equal(t, 50, rl.cost("debug_traceTransaction"))
+		require.Equal(t, 20, rl.cost("debug_traceCall"))
+	})
+
+	t.Run("per ip", func(t *testing.T) {
+		rl := newRateLimiter(log.NewNopLogger(), next, 0.001, 10, 0, 0, costs)
+		getLogs := `{"jsonrpc":"2.0","id":7,"method":"eth_getLogs","params":[]}`
+
+		require.Equal(t, http.StatusOK, call(rl, "1.2.3.4:1000", "", getLogs).Code)
+
+		w := call(rl, "1.2.3.4:1001", "", getLogs)
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		var res struct {
+			ID    int `json:"id"`
+			Error struct {
+				Code int `json:"code"`
+			} `json:"error"`
+		}
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Equal(t, 7, res.ID)
+		require.Equal(t, errCodeLimitExceeded, res.Error.Code)
+
+		// other clients are not limited
+		require.Equal(t, http.StatusOK, call(rl, "5.6.7.8:1000", "", getLogs).Code)
+
+		// the forwarded IP is only trusted from loopback
+		require.Equal(t, http.StatusTooManyRequests, call(rl, "1.2.3.4:1000", "9.9.9.9", getLogs).Code)
+		require.Equal(t, http.StatusOK, call(rl, "127.0.0.1:1000", "9.9.9.9", getLogs).Code)
+		require.Equal(t, http.StatusTooManyRequests, call(rl, "127.0.0.1:1000", "9.9.9.9", getLogs).Code)
+
+		// the leftmost forwarded entries are set by the client and can't bypass the limit
+		require.Equal(t, http.StatusTooManyRequests, call(rl, "127.0.0.1:1000", "8.8.8.8, 9.9.9.9", getLogs).Code)
+		require.Equal(t, http.StatusOK, call(rl, "127.0.0.1:1000", "9.9.9.9, 8.8.8.8", getLogs).Code)
+	})
+
+	t.Run("per method batch", func(t *testing.T) {
+		rl := newRateLimiter(log.NewNopLogger(), next, 0, 0, 0.001, 3, costs)
+		batch := `[{"jsonrpc":"2.0","id":1,"method":"eth_chainId"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`
+
+		require.Equal(t, http.StatusOK, call(rl, "1.2.3.4:1000", "", batch).Code)
+
+		w := call(rl, "5.6.7.8:1000", "", batch)
+		require.Equal(t, http.StatusTooManyRequests, w.Code)
+		var res []json.RawMessage
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+		require.Len(t, res, 2)
+
+		// malformed requests are passed to the rpc server
+		require.Equal(t, http.StatusOK, call(rl, "1.2.3.4:1000", "", "{").Code)
+	})
+
+	t.Run("rejected requests don't consume tokens", func(t *testing.T) {
+		rl := newRateLimiter(log.NewNopLogger(), next, 0.001, 3, 0.001, 1, costs)
+		chainID := `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`
+		batch := `[{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"},{"jsonrpc":"2.0","id":2,"method":"eth_chainId"}]`
+
+		require.Equal(t, http.StatusOK, call(rl, "1.2.3.4:1000", "", chainID).Code)
+
+		// rejected by the eth_chainId limit, the IP and eth_blockNumber tokens are given back
+		for i := 0; i < 3; i++ {
+			require.Equal(t, http.StatusTooManyRequests, call(rl, "1.2.3.4:1000", "", chainID).Code)
+			require.Equal(t, http.StatusTooManyRequests, call(rl, "1.2.3.4:1000", "", batch).Code)
+		}
+
+		blockNumber := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`
+		require.Equal(t, http.StatusOK, call(rl, "1.2.3.4:1000", "", blockNumber).Code)
+		require.Equal(t, http.StatusOK, call(rl, "1.2.3.4:1000", "", `{"jsonrpc":"2.0","id":1,"method":"net_version"}`).Code)
+		require.Equal(t, http.StatusTooManyRequests, call(rl, "1.2.3.4:1000", "", `{"jsonrpc":"2.0","id":1,"method":"web3_clientVersion"}`).Code)
+	})
+
+	t.Run("request too large", func(t *testing.T) {
+		rl := newRateLimiter(log.NewNopLogger(), next, 1, 10, 0, 0, costs)
+
+		// without content length, as a chunked request
+		r := httptest.NewRequest(http.MethodPost, "/", io.MultiReader(
+			strings.NewReader(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId","params":["`),
+			strings.NewReader(strings.Repeat("a", maxRequestContentLength)),
+			strings.NewReader(`"]}`),
+		))
+		require.Equal(t, int64(-1), r.ContentLength)
+		w := httptest.NewRecorder()
+		rl.ServeHTTP(w, r)
+		require.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+}
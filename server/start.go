@@ -37,12 +37,15 @@ import (
 
 	abciserver "github.com/tendermint/tendermint/abci/server"
 	tcmd "github.com/tendermint/tendermint/cmd/tendermint/commands"
+	"github.com/tendermint/tendermint/libs/log"
 	tmos "github.com/tendermint/tendermint/libs/os"
 	"github.com/tendermint/tendermint/node"
 	"github.com/tendermint/tendermint/p2p"
 	pvm "github.com/tendermint/tendermint/privval"
 	"github.com/tendermint/tendermint/proxy"
 	"github.com/tendermint/tendermint/rpc/client/local"
+	sm "github.com/tendermint/tendermint/state"
+	tmstore "github.com/tendermint/tendermint/store"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/cosmos/cosmos-sdk/server/rosetta"
@@ -112,6 +115,11 @@ the halt-height or if the current block time is greater than or equal to the hal
 node will attempt to gracefully shutdown and the block will not be committed. In addition, the node
 will not be able to commit subsequent blocks.
 
+In '--json-rpc-only' mode, neither Tendermint nor the application is started, the JSON-RPC server serves
+the historical blocks, transactions, receipts and logs from the local blockstore, state store and EVM
+indexer databases, which are expected to be a copy of a node's databases. Only the eth, net and web3
+namespaces are available, and only the methods that don't need the application state.
+
 For profiling and benchmarking purposes, CPU profiling can be enabled via the '--cpu-profile' flag
 which accepts a path for the resulting pprof file.
 `,
@@ -135,6 +143,11 @@ which accepts a path for the resulting pprof file.
 				return err
 			}
 
+			if jsonRPCOnly, _ := cmd.Flags().GetBool(srvflags.JSONRPCOnly); jsonRPCOnly {
+				serverCtx.Logger.Info("starting JSON-RPC server only, serving historical data from local databases")
+				return startJSONRPCOnly(serverCtx, clientCtx)
+			}
+
 			withTM, _ := cmd.Flags().GetBool(srvflags.WithTendermint)
 			if !withTM {
 				serverCtx.Logger.Info("starting ABCI without Tendermint")
@@ -209,7 +222,10 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().String(srvflags.JSONRPCAuthAddress, "", "the JWT authenticated JSON-RPC server address serving the non-public namespaces (disabled if empty)") //nolint:lll
+	cmd.Flags().String(srvflags.JSONRPCAuthJWTSecret, "", "Path of the hex encoded JWT secret of the authenticated JSON-RPC server")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
+	cmd.Flags().Bool(srvflags.JSONRPCOnly, false, "Start the JSON-RPC server only, serving historical data from the local blockstore, state store and evm indexer, without Tendermint and the application") //nolint:lll
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
@@ -569,19 +585,7 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		if err != nil {
 			return err
 		}
-		defer func() {
-			shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
-			defer cancelFn()
-			if err := httpSrv.Shutdown(shutdownCtx); err != nil {
-				logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
-			} else {
-				logger.Info("HTTP server shut down, waiting 5 sec")
-				select {
-				case <-time.Tick(5 * time.Second):
-				case <-httpSrvDone:
-				}
-			}
-		}()
+		defer shutdownHTTPServer(logger, httpSrv, httpSrvDone)
 	}
 
 	// At this point it is safe to block the process if we're in query only mode as
@@ -644,6 +648,91 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 	return server.WaitForQuitSignals()
 }
 
+// startJSONRPCOnly serves the historical JSON-RPC apis from the local blockstore, state store
+// and evm indexer databases, without starting Tendermint or the application.
+func startJSONRPCOnly(ctx *server.Context, clientCtx client.Context) error {
+	cfg := ctx.Config
+	home := cfg.RootDir
+	logger := ctx.Logger
+
+	config, err := config.GetConfig(ctx.Viper)
+	if err != nil {
+		logger.Error("failed to get server config", "error", err.Error())
+		return err
+	}
+
+	if err := config.ValidateBasic(); err != nil {
+		logger.Error("invalid server config", "error", err.Error())
+		return err
+	}
+
+	genDoc, err := node.DefaultGenesisDocProviderFunc(cfg)()
+	if err != nil {
+		return err
+	}
+
+	// open local tendermint db, because there's no running node.
+	blockStoreDB, err := node.DefaultDBProvider(&node.DBContext{ID: "blockstore", Config: cfg})
+	if err != nil {
+		logger.Error("failed to open blockstore DB", "error", err.Error())
+		return err
+	}
+	defer blockStoreDB.Close()
+	blockStore := tmstore.NewBlockStore(blockStoreDB)
+
+	stateDB, err := node.DefaultDBProvider(&node.DBContext{ID: "state", Config: cfg})
+	if err != nil {
+		logger.Error("failed to open state DB", "error", err.Error())
+		return err
+	}
+	defer stateDB.Close()
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: cfg.Storage.DiscardABCIResponses,
+	})
+
+	// the custom evm indexer is always used, since there's no tendermint tx indexer available.
+	idxDB, err := OpenIndexerDB(home, server.GetAppDBBackend(ctx.Viper))
+	if err != nil {
+		logger.Error("failed to open evm indexer DB", "error", err.Error())
+		return err
+	}
+	defer idxDB.Close()
+	idxer := indexer.NewKVIndexer(idxDB, ctx.Logger.With("indexer", "evm"), clientCtx)
+
+	clientCtx = clientCtx.
+		WithHomeDir(home).
+		WithChainID(genDoc.ChainID).
+		WithClient(newBlockStoreClient(genDoc.ChainID, blockStore, stateStore))
+
+	if ctx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
+		ethmetricsexp.Setup(config.JSONRPC.MetricsAddress)
+	}
+
+	httpSrv, httpSrvDone, err := StartHistoricalJSONRPC(ctx, clientCtx, &config, idxer)
+	if err != nil {
+		return err
+	}
+	defer shutdownHTTPServer(logger, httpSrv, httpSrvDone)
+
+	// Wait for SIGINT or SIGTERM signal
+	return server.WaitForQuitSignals()
+}
+
+// shutdownHTTPServer gracefully shuts down the JSON-RPC http server.
+func shutdownHTTPServer(logger log.Logger, httpSrv *http.Server, httpSrvDone chan struct{}) {
+	shutdownCtx, cancelFn := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFn()
+	if err := httpSrv.Shutdown(shutdownCtx); err != nil {
+		logger.Error("HTTP server shutdown produced a warning", "error", err.Error())
+	} else {
+		logger.Info("HTTP server shut down, waiting 5 sec")
+		select {
+		case <-time.Tick(5 * time.Second):
+		case <-httpSrvDone:
+		}
+	}
+}
+
 func openDB(_ types.AppOptions, rootDir string, backendType dbm.BackendType) (dbm.DB, error) {
 	dataDir := filepath.Join(rootDir, "data")
 	return dbm.NewDB("application", backendType, dataDir)
@@ -42,6 +42,9 @@ type TxResult struct {
 	// cumulative_gas_used specifies the cumulated amount of gas used for all
 	// processed messages within the current batch transaction.
 	CumulativeGasUsed uint64 `protobuf:"varint,7,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// fee_payer is the hex address of the account which paid the fees of the
+	// transaction in place of the sender, empty if paid by the sender.
+	FeePayer string `protobuf:"bytes,8,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
 }
 
 func (m *TxResult) Reset()         { *m = TxResult{} }
@@ -84,26 +87,27 @@ func init() {
 func init() { proto.RegisterFile("ethermint/types/v1/indexer.proto", fileDescriptor_1197e10a8be8ed28) }
 
 var fileDescriptor_1197e10a8be8ed28 = []byte{
-	// 295 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x18, 0x86, 0x73, 0xb6, 0x4d, 0xe3, 0xa1, 0x83, 0x51, 0x4a, 0x54, 0x88, 0x87, 0x53, 0xa6, 0x84,
-	0xe2, 0xd6, 0xd1, 0x45, 0x5c, 0x8f, 0xba, 0xb8, 0x84, 0xb4, 0xf9, 0xbc, 0x3b, 0xe8, 0xf5, 0x4a,
-	0xef, 0x4b, 0x88, 0xff, 0xc0, 0xd1, 0x9f, 0xe0, 0xcf, 0x71, 0xec, 0xe8, 0x28, 0x2d, 0xfe, 0x0f,
-	0xe9, 0x35, 0x44, 0x70, 0xfb, 0x5e, 0x9e, 0xe7, 0xe3, 0x85, 0x97, 0x32, 0x40, 0x09, 0x6b, 0xad,
-	0x96, 0x98, 0xe1, 0xeb, 0x0a, 0x6c, 0x56, 0x8f, 0x33, 0xb5, 0x2c, 0xa1, 0x81, 0x75, 0xba, 0x5a,
-	0x1b, 0x34, 0x61, 0xd8, 0x19, 0xa9, 0x33, 0xd2, 0x7a, 0x7c, 0x75, 0x21, 0x8c, 0x30, 0x0e, 0x67,
-	0xfb, 0xeb, 0x60, 0xde, 0xfe, 0x10, 0x1a, 0x4c, 0x1b, 0x0e, 0xb6, 0x5a, 0x60, 0x38, 0xa2, 0xbe,
-	0x04, 0x25, 0x24, 0x46, 0x84, 0x91, 0xa4, 0xc7, 0xdb, 0x14, 0x5e, 0xd2, 0x00, 0x9b, 0xdc, 0x55,
-	0x44, 0x47, 0x8c, 0x24, 0xa7, 0x7c, 0x88, 0xcd, 0xe3, 0x3e, 0x86, 0xd7, 0xf4, 0x58, 0x5b, 0xd1,
-	0xb2, 0x9e, 0x63, 0x81, 0xb6, 0xe2, 0x00, 0x19, 0x3d, 0x01, 0x94, 0x79, 0xf7, 0xdb, 0x67, 0x24,
-	0x19, 0x70, 0x0a, 0x28, 0xa7, 0xed, 0xfb, 0x88, 0xfa, 0x2f, 0x85, 0x5a, 0x40, 0x19, 0x0d, 0x18,
-	0x49, 0x02, 0xde, 0xa6, 0x7d, 0xa3, 0x28, 0x6c, 0x5e, 0x59, 0x28, 0x23, 0x9f, 0x91, 0xa4, 0xcf,
-	0x87, 0xa2, 0xb0, 0x4f, 0x16, 0xca, 0x30, 0xa5, 0xe7, 0xf3, 0x4a, 0x57, 0x8b, 0x02, 0x55, 0x0d,
-	0x79, 0x67, 0x0d, 0x9d, 0x75, 0xf6, 0x87, 0x1e, 0x0e, 0xfe, 0xa4, 0xff, 0xf6, 0x71, 0xe3, 0xdd,
-	0x4f, 0x3e, 0xb7, 0x31, 0xd9, 0x6c, 0x63, 0xf2, 0xbd, 0x8d, 0xc9, 0xfb, 0x2e, 0xf6, 0x36, 0xbb,
-	0xd8, 0xfb, 0xda, 0xc5, 0xde, 0x33, 0x13, 0x0a, 0x65, 0x35, 0x4b, 0xe7, 0x46, 0x67, 0x50, 0x6b,
-	0x63, 0xb3, 0x7f, 0xf3, 0xce, 0x7c, 0x37, 0xd5, 0xdd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc1,
-	0x34, 0xa8, 0x0b, 0x78, 0x01, 0x00, 0x00,
+	// 311 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x3f, 0x4b, 0xc3, 0x40,
+	0x14, 0xc0, 0x73, 0xfd, 0x93, 0xa6, 0x87, 0x0e, 0x46, 0x29, 0xd1, 0x42, 0x3c, 0x9c, 0x32, 0x25,
+	0x14, 0xb7, 0x8e, 0x2e, 0xe2, 0x26, 0x47, 0x5d, 0x5c, 0x42, 0xda, 0xbc, 0x5e, 0x02, 0xbd, 0x5e,
+	0xc9, 0xbd, 0x84, 0x74, 0x75, 0x72, 0xf4, 0x23, 0xf8, 0x71, 0x1c, 0x3b, 0x3a, 0x4a, 0xfb, 0x45,
+	0x24, 0xd7, 0x50, 0xc1, 0xed, 0xfd, 0xf8, 0xfd, 0x1e, 0x0f, 0x1e, 0x65, 0x80, 0x19, 0x14, 0x32,
+	0x5f, 0x63, 0x84, 0xdb, 0x0d, 0xe8, 0xa8, 0x9a, 0x44, 0xf9, 0x3a, 0x85, 0x1a, 0x8a, 0x70, 0x53,
+	0x28, 0x54, 0xae, 0x7b, 0x2a, 0x42, 0x53, 0x84, 0xd5, 0xe4, 0xe6, 0x4a, 0x28, 0xa1, 0x8c, 0x8e,
+	0x9a, 0xe9, 0x58, 0xde, 0xbd, 0x75, 0xa8, 0x33, 0xab, 0x39, 0xe8, 0x72, 0x85, 0xee, 0x88, 0xda,
+	0x19, 0xe4, 0x22, 0x43, 0x8f, 0x30, 0x12, 0x74, 0x79, 0x4b, 0xee, 0x35, 0x75, 0xb0, 0x8e, 0xcd,
+	0x09, 0xaf, 0xc3, 0x48, 0x70, 0xce, 0x07, 0x58, 0x3f, 0x35, 0xe8, 0x8e, 0xe9, 0x50, 0x6a, 0xd1,
+	0xba, 0xae, 0x71, 0x8e, 0xd4, 0xe2, 0x28, 0x19, 0x3d, 0x03, 0xcc, 0xe2, 0xd3, 0x6e, 0x8f, 0x91,
+	0xa0, 0xcf, 0x29, 0x60, 0x36, 0x6b, 0xd7, 0x47, 0xd4, 0x5e, 0x26, 0xf9, 0x0a, 0x52, 0xaf, 0xcf,
+	0x48, 0xe0, 0xf0, 0x96, 0x9a, 0x8b, 0x22, 0xd1, 0x71, 0xa9, 0x21, 0xf5, 0x6c, 0x46, 0x82, 0x1e,
+	0x1f, 0x88, 0x44, 0xbf, 0x68, 0x48, 0xdd, 0x90, 0x5e, 0x2e, 0x4a, 0x59, 0xae, 0x12, 0xcc, 0x2b,
+	0x88, 0x4f, 0xd5, 0xc0, 0x54, 0x17, 0x7f, 0xea, 0xb1, 0xed, 0xc7, 0x74, 0xb8, 0x04, 0x88, 0x37,
+	0xc9, 0x16, 0x0a, 0xcf, 0x61, 0x24, 0x18, 0x72, 0x67, 0x09, 0xf0, 0xdc, 0xf0, 0xb4, 0xf7, 0xfe,
+	0x79, 0x6b, 0x3d, 0x4c, 0xbf, 0xf6, 0x3e, 0xd9, 0xed, 0x7d, 0xf2, 0xb3, 0xf7, 0xc9, 0xc7, 0xc1,
+	0xb7, 0x76, 0x07, 0xdf, 0xfa, 0x3e, 0xf8, 0xd6, 0x2b, 0x13, 0x39, 0x66, 0xe5, 0x3c, 0x5c, 0x28,
+	0x19, 0x41, 0x25, 0x95, 0x8e, 0xfe, 0xfd, 0x7e, 0x6e, 0x9b, 0x3f, 0xde, 0xff, 0x0e, 0x00, 0x6f,
+	0x12, 0x49, 0x57, 0x95, 0x01, 0x00, 0x00,
 }
 
 func (m *TxResult) Marshal() (dAtA []byte, err error) {
@@ -126,6 +130,13 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.CumulativeGasUsed != 0 {
 		i = encodeVarintIndexer(dAtA, i, uint64(m.CumulativeGasUsed))
 		i--
@@ -207,6 +218,10 @@ func (m *TxResult) Size() (n int) {
 	if m.CumulativeGasUsed != 0 {
 		n += 1 + sovIndexer(uint64(m.CumulativeGasUsed))
 	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
 	return n
 }
 
@@ -379,6 +394,38 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndexer(dAtA[iNdEx:])
@@ -83,6 +83,17 @@ func InitGenesis(
 		}
 	}
 
+	// NOTE: the system contracts are exported as regular genesis accounts
+	for _, contract := range data.SystemContracts {
+		if _, err := k.DeploySystemContract(ctx, contract, false); err != nil {
+			panic(fmt.Errorf("error deploying system contract %s: %w", contract.Address, err))
+		}
+	}
+
+	for _, address := range data.BlockedAddresses {
+		k.SetBlockedAddress(ctx, common.HexToAddress(address))
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
@@ -110,8 +121,14 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper, ak types.AccountKeeper) *t
 		return false
 	})
 
+	var blockedAddresses []string
+	for _, addr := range k.GetBlockedAddresses(ctx) {
+		blockedAddresses = append(blockedAddresses, addr.Hex())
+	}
+
 	return &types.GenesisState{
-		Accounts: ethGenAccounts,
-		Params:   k.GetParams(ctx),
+		Accounts:         ethGenAccounts,
+		Params:           k.GetParams(ctx),
+		BlockedAddresses: blockedAddresses,
 	}
 }
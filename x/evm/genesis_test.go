@@ -136,6 +136,49 @@ func (suite *EvmTestSuite) TestInitGenesis() {
 			},
 			false,
 		},
+		{
+			"valid system contract",
+			func() {},
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				SystemContracts: []types.SystemContract{
+					{
+						Address: address.String(),
+						Code:    "6000",
+						Storage: types.Storage{
+							{Key: common.BytesToHash([]byte("key")).String(), Value: common.BytesToHash([]byte("value")).String()},
+						},
+					},
+				},
+			},
+			false,
+		},
+		{
+			"invalid system contract account type",
+			func() {
+				acc := authtypes.NewBaseAccountWithAddress(address.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			},
+			&types.GenesisState{
+				Params: types.DefaultParams(),
+				SystemContracts: []types.SystemContract{
+					{
+						Address: address.String(),
+						Code:    "6000",
+					},
+				},
+			},
+			true,
+		},
+		{
+			"valid blocked address",
+			func() {},
+			&types.GenesisState{
+				Params:           types.DefaultParams(),
+				BlockedAddresses: []string{address.String()},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -569,7 +569,7 @@ func (suite *EvmTestSuite) TestERC20TransferReverted() {
 		suite.Run(tc.msg, func() {
 			suite.SetupTest()
 			k := suite.app.EvmKeeper
-			k.SetHooks(tc.hooks)
+			k.CleanHooks().SetHooks(tc.hooks)
 
 			// add some fund to pay gas fee
 			k.SetBalance(suite.ctx, suite.from, big.NewInt(1000000000000000))
@@ -658,7 +658,7 @@ func (suite *EvmTestSuite) TestContractDeploymentRevert() {
 			k := suite.app.EvmKeeper
 
 			// test with different hooks scenarios
-			k.SetHooks(tc.hooks)
+			k.CleanHooks().SetHooks(tc.hooks)
 
 			nonce := k.GetNonce(suite.ctx, suite.from)
 			ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.from, big.NewInt(0))
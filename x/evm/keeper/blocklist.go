@@ -0,0 +1,84 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// IsBlockedAddress returns true if the address is not allowed to send or receive EVM value.
+func (k Keeper) IsBlockedAddress(ctx sdk.Context, addr common.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.BlockedAddressKey(addr))
+}
+
+// SetBlockedAddress adds the address to the blocklist.
+func (k Keeper) SetBlockedAddress(ctx sdk.Context, addr common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockedAddressKey(addr), []byte{1})
+}
+
+// DeleteBlockedAddress removes the address from the blocklist.
+func (k Keeper) DeleteBlockedAddress(ctx sdk.Context, addr common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BlockedAddressKey(addr))
+}
+
+// GetBlockedAddresses returns all the blocked addresses.
+func (k Keeper) GetBlockedAddresses(ctx sdk.Context) []common.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockedAddress)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var addresses []common.Address
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, common.BytesToAddress(iterator.Key()))
+	}
+	return addresses
+}
+
+// rejecter is implemented by the StateDB to reject the EVM execution.
+type rejecter interface {
+	Reject(err error)
+}
+
+// Transfer returns the EVM transfer function. The value transfers from or to a blocked address
+// are skipped and reject the whole execution.
+func (k Keeper) Transfer(ctx sdk.Context) vm.TransferFunc {
+	return func(db vm.StateDB, sender, recipient common.Address, amount *big.Int) {
+		if amount.Sign() > 0 {
+			for _, addr := range []common.Address{sender, recipient} {
+				if !k.IsBlockedAddress(ctx, addr) {
+					continue
+				}
+				if r, ok := db.(rejecter); ok {
+					r.Reject(types.BlockedAddressError{Address: addr})
+				}
+				return
+			}
+		}
+
+		core.Transfer(db, sender, recipient, amount)
+	}
+}
@@ -0,0 +1,236 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"encoding/json"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// DefaultModuleCallGasCap is the gas limit applied to the EVM calls initiated by
+// the modules through CallEVM and CallEVMWithData.
+const DefaultModuleCallGasCap uint64 = 25_000_000
+
+// ethTxContextKey marks the contexts derived from an ethereum transaction execution.
+type ethTxContextKey struct{}
+
+// isEthTxContext returns true if the context is derived from an ethereum transaction execution,
+// e.g. the context passed to the EVM hooks.
+func isEthTxContext(ctx sdk.Context) bool {
+	v, ok := ctx.Value(ethTxContextKey{}).(bool)
+	return ok && v
+}
+
+// CallEVM packs the method arguments with the contract ABI and performs a smart contract
+// call on behalf of the from address, usually a module account. See CallEVMWithData.
+func (k *Keeper) CallEVM(
+	ctx sdk.Context,
+	contractABI abi.ABI,
+	from, contract common.Address,
+	commit bool,
+	method string,
+	args ...interface{},
+) (*types.MsgEthereumTxResponse, error) {
+	data, err := contractABI.Pack(method, args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to pack arguments of method %s", method)
+	}
+
+	return k.CallEVMWithData(ctx, from, &contract, data, commit)
+}
+
+// CallEVMWithData performs an EVM call or contract creation (if contract is nil) on behalf of
+// the from address with the given input data. The message is executed with a zero gas price
+// and value, its gas limit is capped by DefaultModuleCallGasCap and by the gas left in the
+// context gas meter.
+//
+// If commit is false the call is only simulated and the state is left untouched. Otherwise the
+// gas used is consumed from the context gas meter, even if the call fails, and on success the
+// state changes are committed, the nonce of the sender is incremented and the same ethereum_tx
+// and tx_log events as a user transaction are emitted so that the call is visible to the block
+// explorers. The events are not emitted when the call is performed within an ethereum transaction
+// (i.e from the EVM hooks), since they would be attributed to the wrong transaction by the
+// JSON-RPC, the caller can append the returned logs to the transaction receipt instead.
+//
+// The call doesn't take a transaction index in the block, which is only advanced by the ethereum
+// transactions to stay consistent with the JSON-RPC indexer, so its ethereum_tx event has no txIndex
+// attribute and its logs share the index of the next ethereum transaction.
+//
+// An error is returned if the EVM execution fails or is reverted.
+func (k *Keeper) CallEVMWithData(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	data []byte,
+	commit bool,
+) (*types.MsgEthereumTxResponse, error) {
+	cfg, err := k.EVMConfig(ctx, sdk.ConsAddress(ctx.BlockHeader().ProposerAddress), k.eip155ChainID)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to load evm config")
+	}
+
+	nonce := k.GetNonce(ctx, from)
+
+	gasCap := DefaultModuleCallGasCap
+	gasMeter := ctx.GasMeter()
+	if remaining := gasMeter.Limit() - gasMeter.GasConsumedToLimit(); remaining < gasCap {
+		gasCap = remaining
+	}
+
+	// the hash of the unsigned legacy transaction identifies the call in the logs and events
+	txHash := ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    nonce,
+		GasPrice: big.NewInt(0),
+		Gas:      gasCap,
+		To:       contract,
+		Value:    big.NewInt(0),
+		Data:     data,
+	}).Hash()
+
+	msg := ethtypes.NewMessage(
+		from,
+		contract,
+		nonce,
+		big.NewInt(0), // amount
+		gasCap,        // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		data,
+		ethtypes.AccessList{},
+		!commit, // isFake
+	)
+
+	txConfig := k.TxConfig(ctx, txHash)
+	res, err := k.ApplyMessageWithConfig(ctx, msg, nil, commit, cfg, txConfig)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to apply module call")
+	}
+
+	// the failed calls are charged as well, their execution is bounded by the gas left in the meter
+	if commit {
+		gasMeter.ConsumeGas(res.GasUsed, "evm module call")
+	}
+
+	if res.Failed() {
+		return nil, errorsmod.Wrap(types.ErrVMExecution, res.VmError)
+	}
+
+	if !commit {
+		return res, nil
+	}
+
+	// the nonce of the contract creations is already set by the state transition
+	if contract != nil {
+		if err := k.incrementNonce(ctx, from); err != nil {
+			return nil, err
+		}
+	}
+
+	if isEthTxContext(ctx) {
+		return res, nil
+	}
+
+	if len(res.Logs) > 0 {
+		logs := types.LogsToEthereum(res.Logs)
+		bloom := k.GetBlockBloomTransient(ctx)
+		bloom.Or(bloom, big.NewInt(0).SetBytes(ethtypes.LogsBloom(logs)))
+		k.SetBlockBloomTransient(ctx, bloom)
+		k.SetLogSizeTransient(ctx, uint64(txConfig.LogIndex)+uint64(len(logs)))
+	}
+
+	if err := emitModuleCallEvents(ctx, from, contract, nonce, res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// incrementNonce increments the sequence of the account, creating it if it doesn't exist.
+func (k *Keeper) incrementNonce(ctx sdk.Context, addr common.Address) error {
+	cosmosAddr := sdk.AccAddress(addr.Bytes())
+	acct := k.accountKeeper.GetAccount(ctx, cosmosAddr)
+	if acct == nil {
+		acct = k.accountKeeper.NewAccountWithAddress(ctx, cosmosAddr)
+	}
+
+	if err := acct.SetSequence(acct.GetSequence() + 1); err != nil {
+		return errorsmod.Wrapf(err, "failed to increment nonce of %s", addr)
+	}
+
+	k.accountKeeper.SetAccount(ctx, acct)
+	return nil
+}
+
+// emitModuleCallEvents emits the ethereum_tx and tx_log events of a module call, using the
+// same attributes as the ones emitted by the EthereumTx message server.
+func emitModuleCallEvents(
+	ctx sdk.Context,
+	from common.Address,
+	contract *common.Address,
+	nonce uint64,
+	res *types.MsgEthereumTxResponse,
+) error {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyAmount, "0"),
+		sdk.NewAttribute(types.AttributeKeyEthereumTxHash, res.Hash),
+		sdk.NewAttribute(types.AttributeKeyTxGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+	}
+
+	if contract != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, contract.Hex()))
+	} else {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyContractAddress, crypto.CreateAddress(from, nonce).Hex()))
+	}
+
+	txLogAttrs := make([]sdk.Attribute, len(res.Logs))
+	for i, log := range res.Logs {
+		value, err := json.Marshal(log)
+		if err != nil {
+			return errorsmod.Wrap(err, "failed to encode log")
+		}
+		txLogAttrs[i] = sdk.NewAttribute(types.AttributeKeyTxLog, string(value))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeEthereumTx,
+			attrs...,
+		),
+		sdk.NewEvent(
+			types.EventTypeTxLog,
+			txLogAttrs...,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.Hex()),
+		),
+	})
+
+	return nil
+}
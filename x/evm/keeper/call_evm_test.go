@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+
+	"github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// ModuleCallHook transfers tokens from a module account after each transaction
+type ModuleCallHook struct {
+	k        *keeper.Keeper
+	from     common.Address
+	contract common.Address
+}
+
+func (h ModuleCallHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	_, err := h.k.CallEVM(ctx, types.ERC20Contract.ABI, h.from, h.contract, true, "transfer", common.BigToAddress(big.NewInt(2)), big.NewInt(1))
+	return err
+}
+
+func (suite *KeeperTestSuite) TestCallEVM() {
+	suite.SetupTest()
+
+	moduleAddr := common.BytesToAddress(authtypes.NewModuleAddress(types.ModuleName))
+	contractAddr := suite.DeployTestContract(suite.T(), moduleAddr, big.NewInt(1000))
+	to := common.BigToAddress(big.NewInt(1))
+
+	balanceOf := func(addr common.Address) *big.Int {
+		res, err := suite.app.EvmKeeper.CallEVM(suite.ctx, types.ERC20Contract.ABI, moduleAddr, contractAddr, false, "balanceOf", addr)
+		suite.Require().NoError(err)
+		out, err := types.ERC20Contract.ABI.Unpack("balanceOf", res.Ret)
+		suite.Require().NoError(err)
+		return out[0].(*big.Int)
+	}
+
+	// query
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, moduleAddr)
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.Require().Equal(big.NewInt(1000), balanceOf(moduleAddr))
+	suite.Require().Equal(nonce, suite.app.EvmKeeper.GetNonce(suite.ctx, moduleAddr))
+	suite.Require().Empty(suite.ctx.EventManager().Events())
+
+	// simulated transfer doesn't change the state
+	_, err := suite.app.EvmKeeper.CallEVM(suite.ctx, types.ERC20Contract.ABI, moduleAddr, contractAddr, false, "transfer", to, big.NewInt(10))
+	suite.Require().NoError(err)
+	suite.Require().Zero(balanceOf(to).Sign())
+
+	// committed transfer
+	res, err := suite.app.EvmKeeper.CallEVM(suite.ctx, types.ERC20Contract.ABI, moduleAddr, contractAddr, true, "transfer", to, big.NewInt(10))
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Logs, 1)
+	suite.Require().Equal(big.NewInt(10), balanceOf(to))
+	suite.Require().Equal(nonce+1, suite.app.EvmKeeper.GetNonce(suite.ctx, moduleAddr))
+
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Len(events, 3)
+	suite.Require().Equal(types.EventTypeEthereumTx, events[0].Type)
+	suite.Require().Equal(types.EventTypeTxLog, events[1].Type)
+	suite.Require().Len(events[1].Attributes, 1)
+
+	// reverted transfer still consumes its EVM gas, on top of the store gas of a simulated one
+	simGasMeter := sdk.NewGasMeter(1_000_000)
+	_, err = suite.app.EvmKeeper.CallEVM(suite.ctx.WithGasMeter(simGasMeter), types.ERC20Contract.ABI, moduleAddr, contractAddr, false, "transfer", to, big.NewInt(10000))
+	suite.Require().ErrorIs(err, types.ErrVMExecution)
+
+	gasMeter := sdk.NewGasMeter(1_000_000)
+	_, err = suite.app.EvmKeeper.CallEVM(suite.ctx.WithGasMeter(gasMeter), types.ERC20Contract.ABI, moduleAddr, contractAddr, true, "transfer", to, big.NewInt(10000))
+	suite.Require().ErrorIs(err, types.ErrVMExecution)
+	suite.Require().Equal(nonce+1, suite.app.EvmKeeper.GetNonce(suite.ctx, moduleAddr))
+	suite.Require().Greater(gasMeter.GasConsumed(), simGasMeter.GasConsumed()+params.TxGas)
+
+	// unknown method
+	_, err = suite.app.EvmKeeper.CallEVM(suite.ctx, types.ERC20Contract.ABI, moduleAddr, contractAddr, true, "unknown")
+	suite.Require().Error(err)
+
+	// module call within an ethereum transaction doesn't emit the transaction events
+	suite.app.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(ModuleCallHook{suite.app.EvmKeeper, moduleAddr, contractAddr}))
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, to, big.NewInt(0))
+	suite.Require().Equal(big.NewInt(1), balanceOf(common.BigToAddress(big.NewInt(2))))
+
+	var ethTxEvents int
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type == types.EventTypeEthereumTx {
+			ethTxEvents++
+		}
+	}
+	suite.Require().Equal(1, ethTxEvents)
+}
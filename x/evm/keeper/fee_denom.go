@@ -0,0 +1,122 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// GetFeeDenomConversionRate returns the amount of denom units paid per unit of the EVM denom. The
+// static conversion rate of the fee denom is used if set, otherwise the rate of the price oracle.
+func (k Keeper) GetFeeDenomConversionRate(ctx sdk.Context, params types.Params, denom string) (sdk.Dec, error) {
+	feeDenom, found := params.GetFeeDenom(denom)
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "%s is not accepted to pay fees", denom)
+	}
+
+	rate := feeDenom.ConversionRate
+	if rate.IsNil() || rate.IsZero() {
+		if k.feeDenomOracle == nil {
+			return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "no price oracle for %s", denom)
+		}
+
+		var err error
+		rate, err = k.feeDenomOracle.GetConversionRate(ctx, denom)
+		if err != nil {
+			return sdk.Dec{}, errorsmod.Wrapf(err, "failed to get the conversion rate of %s", denom)
+		}
+	}
+
+	if rate.IsNil() || !rate.IsPositive() {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidFeeDenom, "invalid conversion rate %s for %s", rate, denom)
+	}
+
+	return rate, nil
+}
+
+// ConvertFees converts the fee amount in the EVM denom to the given fee denom, rounding up.
+func (k Keeper) ConvertFees(ctx sdk.Context, params types.Params, amount sdkmath.Int, denom string) (sdk.Coin, error) {
+	rate, err := k.GetFeeDenomConversionRate(ctx, params, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(denom, sdk.NewDecFromInt(amount).Mul(rate).Ceil().TruncateInt()), nil
+}
+
+// GetTxFeeCoins returns the coins paid by the sender for the fees of an Ethereum transaction, given
+// in the EVM denom. The fees are paid in the EVM denom if the sender balance covers them along with
+// the transaction value, otherwise in the first alternative fee denom the sender can afford.
+func (k Keeper) GetTxFeeCoins(ctx sdk.Context, fees sdk.Coins, value *big.Int, from common.Address) sdk.Coins {
+	params := k.GetParams(ctx)
+	if len(params.FeeDenoms) == 0 || fees.IsZero() {
+		return fees
+	}
+
+	cosmosAddr := sdk.AccAddress(from.Bytes())
+	amount := fees.AmountOf(params.EvmDenom)
+
+	cost := amount
+	if value != nil {
+		cost = cost.Add(sdkmath.NewIntFromBigInt(value))
+	}
+
+	if k.bankKeeper.GetBalance(ctx, cosmosAddr, params.EvmDenom).Amount.GTE(cost) {
+		return fees
+	}
+
+	for _, feeDenom := range params.FeeDenoms {
+		coin, err := k.ConvertFees(ctx, params, amount, feeDenom.Denom)
+		if err != nil {
+			k.Logger(ctx).Debug("failed to convert fees", "denom", feeDenom.Denom, "error", err.Error())
+			continue
+		}
+
+		if k.bankKeeper.GetBalance(ctx, cosmosAddr, coin.Denom).IsGTE(coin) {
+			return sdk.Coins{coin}
+		}
+	}
+
+	// the deduction of the fees in the EVM denom fails with insufficient funds
+	return fees
+}
+
+// SetTxFeesTransient records the fees paid in an alternative fee denom by the Ethereum transaction,
+// so that the leftover gas is refunded in the same denom.
+func (k Keeper) SetTxFeesTransient(ctx sdk.Context, txHash common.Hash, fees sdk.Coin) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.TxFeesTransientKey(txHash), k.cdc.MustMarshal(&fees))
+}
+
+// GetTxFeesTransient returns the fees paid in an alternative fee denom by the Ethereum transaction.
+func (k Keeper) GetTxFeesTransient(ctx sdk.Context, txHash common.Hash) (sdk.Coin, bool) {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.TxFeesTransientKey(txHash))
+	if len(bz) == 0 {
+		return sdk.Coin{}, false
+	}
+
+	var fees sdk.Coin
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees, true
+}
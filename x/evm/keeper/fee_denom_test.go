@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+const testFeeDenom = "uusdc"
+
+// StaticFeeDenomOracle returns the same conversion rate for every denom
+type StaticFeeDenomOracle struct {
+	rate sdk.Dec
+}
+
+func (o StaticFeeDenomOracle) GetConversionRate(ctx sdk.Context, denom string) (sdk.Dec, error) {
+	return o.rate, nil
+}
+
+func (suite *KeeperTestSuite) TestGetTxFeeCoins() {
+	from := tests.GenerateAddress()
+	evmDenom := types.DefaultEVMDenom
+	fees := sdk.NewCoins(sdk.NewInt64Coin(evmDenom, 100))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		value    *big.Int
+		expFees  sdk.Coins
+	}{
+		{
+			"no fee denoms",
+			func() {},
+			nil,
+			fees,
+		},
+		{
+			"evm denom balance covers the fees",
+			func() {
+				suite.setFeeDenoms(types.FeeDenom{Denom: testFeeDenom, ConversionRate: sdk.NewDecWithPrec(5, 1)})
+				suite.fundAccount(from, sdk.NewInt64Coin(evmDenom, 100), sdk.NewInt64Coin(testFeeDenom, 100))
+			},
+			nil,
+			fees,
+		},
+		{
+			"evm denom balance doesn't cover the fees and the value",
+			func() {
+				suite.setFeeDenoms(types.FeeDenom{Denom: testFeeDenom, ConversionRate: sdk.NewDecWithPrec(5, 1)})
+				suite.fundAccount(from, sdk.NewInt64Coin(evmDenom, 100), sdk.NewInt64Coin(testFeeDenom, 100))
+			},
+			big.NewInt(1),
+			sdk.NewCoins(sdk.NewInt64Coin(testFeeDenom, 50)),
+		},
+		{
+			"static conversion rate",
+			func() {
+				suite.setFeeDenoms(types.FeeDenom{Denom: testFeeDenom, ConversionRate: sdk.NewDecWithPrec(5, 1)})
+				suite.fundAccount(from, sdk.NewInt64Coin(testFeeDenom, 100))
+			},
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin(testFeeDenom, 50)),
+		},
+		{
+			"oracle conversion rate",
+			func() {
+				suite.app.EvmKeeper.SetFeeDenomOracle(StaticFeeDenomOracle{rate: sdk.NewDec(2)})
+				suite.setFeeDenoms(types.FeeDenom{Denom: testFeeDenom})
+				suite.fundAccount(from, sdk.NewInt64Coin(testFeeDenom, 200))
+			},
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin(testFeeDenom, 200)),
+		},
+		{
+			"no price oracle",
+			func() {
+				suite.setFeeDenoms(types.FeeDenom{Denom: testFeeDenom})
+				suite.fundAccount(from, sdk.NewInt64Coin(testFeeDenom, 200))
+			},
+			nil,
+			fees,
+		},
+		{
+			"first affordable fee denom",
+			func() {
+				suite.setFeeDenoms(
+					types.FeeDenom{Denom: "uatom", ConversionRate: sdk.NewDec(1)},
+					types.FeeDenom{Denom: testFeeDenom, ConversionRate: sdk.NewDec(1)},
+				)
+				suite.fundAccount(from, sdk.NewInt64Coin("uatom", 99), sdk.NewInt64Coin(testFeeDenom, 100))
+			},
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin(testFeeDenom, 100)),
+		},
+		{
+			"no affordable fee denom",
+			func() {
+				suite.setFeeDenoms(types.FeeDenom{Denom: testFeeDenom, ConversionRate: sdk.NewDec(1)})
+				suite.fundAccount(from, sdk.NewInt64Coin(testFeeDenom, 99))
+			},
+			nil,
+			fees,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			suite.Require().Equal(tc.expFees, suite.app.EvmKeeper.GetTxFeeCoins(suite.ctx, fees, tc.value, from))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundGasInFeeDenom() {
+	suite.SetupTest()
+
+	from := tests.GenerateAddress()
+	fees := sdk.NewInt64Coin(testFeeDenom, 50)
+	suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, authtypes.FeeCollectorName, sdk.NewCoins(fees)))
+
+	payer := tests.GenerateAddress()
+	msg := ethtypes.NewMessage(from, &common.Address{}, 0, nil, 100, big.NewInt(1), nil, nil, nil, nil, false)
+	suite.Require().NoError(suite.app.EvmKeeper.RefundGasInFeeDenom(suite.ctx, msg, payer, 40, fees))
+
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(payer.Bytes()), testFeeDenom)
+	suite.Require().Equal(sdkmath.NewInt(20), balance.Amount)
+	suite.Require().True(suite.app.BankKeeper.GetBalance(suite.ctx, sdk.AccAddress(from.Bytes()), testFeeDenom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestBaseFeePortion() {
+	testCases := []struct {
+		name     string
+		gasPrice *big.Int
+		fees     sdk.Coin
+		expFees  sdk.Coins
+	}{
+		{"fees in evm denom", big.NewInt(200), sdk.NewInt64Coin(types.DefaultEVMDenom, 20000), sdk.NewCoins(sdk.NewInt64Coin(types.DefaultEVMDenom, 5000))},
+		{"fees in fee denom", big.NewInt(200), sdk.NewInt64Coin(testFeeDenom, 40), sdk.NewCoins(sdk.NewInt64Coin(testFeeDenom, 10))},
+		{"zero gas price", big.NewInt(0), sdk.NewInt64Coin(types.DefaultEVMDenom, 0), sdk.Coins{}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := ethtypes.NewMessage(suite.address, &common.Address{}, 0, big.NewInt(0), 100, tc.gasPrice, tc.gasPrice, tc.gasPrice, nil, nil, true)
+			fees := keeper.BaseFeePortion(msg, 50, big.NewInt(100), tc.fees)
+			suite.Require().Equal(tc.expFees, fees)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEffectiveTip() {
+	testCases := []struct {
+		name     string
+		gasPrice *big.Int
+		baseFee  *big.Int
+		expTip   *big.Int
+	}{
+		{"base fee disabled", big.NewInt(200), nil, big.NewInt(200)},
+		{"tip on top of the base fee", big.NewInt(200), big.NewInt(150), big.NewInt(50)},
+		{"gas price lower than the base fee", big.NewInt(100), big.NewInt(150), big.NewInt(0)},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			msg := ethtypes.NewMessage(suite.address, &common.Address{}, 0, big.NewInt(0), 100, tc.gasPrice, tc.gasPrice, tc.gasPrice, nil, nil, true)
+			suite.Require().Equal(tc.expTip, keeper.EffectiveTip(msg, tc.baseFee))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) setFeeDenoms(feeDenoms ...types.FeeDenom) {
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
+	params.FeeDenoms = feeDenoms
+	suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+}
+
+func (suite *KeeperTestSuite) fundAccount(addr common.Address, coins ...sdk.Coin) {
+	suite.Require().NoError(testutil.FundAccount(suite.app.BankKeeper, suite.ctx, addr.Bytes(), sdk.NewCoins(coins...)))
+}
@@ -0,0 +1,41 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// SetTxFeePayerTransient records the account paying the fees of the Ethereum transaction in place
+// of the sender, so that the leftover gas is refunded to it.
+func (k Keeper) SetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash, payer common.Address) {
+	store := ctx.TransientStore(k.transientKey)
+	store.Set(types.TxFeePayerTransientKey(txHash), payer.Bytes())
+}
+
+// GetTxFeePayerTransient returns the account paying the fees of the Ethereum transaction in place of
+// the sender.
+func (k Keeper) GetTxFeePayerTransient(ctx sdk.Context, txHash common.Hash) (common.Address, bool) {
+	store := ctx.TransientStore(k.transientKey)
+	bz := store.Get(types.TxFeePayerTransientKey(txHash))
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
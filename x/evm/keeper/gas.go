@@ -18,6 +18,7 @@ package keeper
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -44,6 +45,12 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 // returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
 // AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
+	return k.RefundGasToPayer(ctx, msg, msg.From(), leftoverGas, denom)
+}
+
+// RefundGasToPayer transfers the leftover gas to the account which paid the fees of the message, that
+// is either the sender or the fee granter.
+func (k *Keeper) RefundGasToPayer(ctx sdk.Context, msg core.Message, payer common.Address, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
 
@@ -54,14 +61,7 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	case 1:
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
-
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
-
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
-		if err != nil {
-			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
-			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
-		}
+		return k.refundCoins(ctx, payer, leftoverGas, refundedCoins)
 	default:
 		// no refund, consume gas and update the tx gas meter
 	}
@@ -69,6 +69,64 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// RefundGasInFeeDenom transfers the leftover gas to the fee payer of a message whose fees were paid in
+// an alternative fee denom, exchanged at the rate of the fees paid.
+func (k *Keeper) RefundGasInFeeDenom(ctx sdk.Context, msg core.Message, payer common.Address, leftoverGas uint64, fees sdk.Coin) error {
+	if msg.Gas() == 0 {
+		return nil
+	}
+
+	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), fees.Amount.BigInt())
+	remaining.Quo(remaining, new(big.Int).SetUint64(msg.Gas()))
+	if remaining.Sign() == 0 {
+		return nil
+	}
+
+	refundedCoins := sdk.Coins{sdk.NewCoin(fees.Denom, sdkmath.NewIntFromBigInt(remaining))}
+	return k.refundCoins(ctx, payer, leftoverGas, refundedCoins)
+}
+
+// BaseFeePortion returns the base fee portion of the fees paid for the gas used by a transaction,
+// in the denomination the fees were paid in. The fees are split in proportion of the base fee in
+// the effective gas price of the message.
+func BaseFeePortion(msg core.Message, gasUsed uint64, baseFee *big.Int, fees sdk.Coin) sdk.Coins {
+	paid := new(big.Int).Mul(msg.GasPrice(), new(big.Int).SetUint64(msg.Gas()))
+	if paid.Sign() == 0 {
+		return sdk.Coins{}
+	}
+
+	portion := new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasUsed))
+	portion.Mul(portion, fees.Amount.BigInt())
+	portion.Quo(portion, paid)
+
+	return sdk.NewCoins(sdk.NewCoin(fees.Denom, sdkmath.NewIntFromBigInt(portion)))
+}
+
+// EffectiveTip returns the tip paid per unit of gas by a transaction on top of the base fee,
+// which is the whole effective gas price if the base fee is disabled.
+func EffectiveTip(msg core.Message, baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return new(big.Int).Set(msg.GasPrice())
+	}
+
+	tip := new(big.Int).Sub(msg.GasPrice(), baseFee)
+	if tip.Sign() < 0 {
+		return new(big.Int)
+	}
+	return tip
+}
+
+// refundCoins refunds the coins to the fee payer from the fee collector module account, which is the
+// escrow account in charge of collecting tx fees.
+func (k *Keeper) refundCoins(ctx sdk.Context, payer common.Address, leftoverGas uint64, refundedCoins sdk.Coins) error {
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, payer.Bytes(), refundedCoins)
+	if err != nil {
+		err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
+		return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
+	}
+	return nil
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
@@ -23,7 +23,10 @@ import (
 	"github.com/evmos/ethermint/x/evm/types"
 )
 
-var _ types.EvmHooks = MultiEvmHooks{}
+var (
+	_ types.EvmHooks   = MultiEvmHooks{}
+	_ types.EvmTxHooks = MultiEvmHooks{}
+)
 
 // MultiEvmHooks combine multiple evm hooks, all hook functions are run in array sequence
 type MultiEvmHooks []types.EvmHooks
@@ -42,3 +45,31 @@ func (mh MultiEvmHooks) PostTxProcessing(ctx sdk.Context, msg core.Message, rece
 	}
 	return nil
 }
+
+// PreTxExecution delegate the call to underlying hooks implementing EvmTxHooks
+func (mh MultiEvmHooks) PreTxExecution(ctx sdk.Context, msg core.Message) error {
+	for i := range mh {
+		txHooks, ok := mh[i].(types.EvmTxHooks)
+		if !ok {
+			continue
+		}
+		if err := txHooks.PreTxExecution(ctx, msg); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
+
+// PostTxExecution delegate the call to underlying hooks implementing EvmTxHooks
+func (mh MultiEvmHooks) PostTxExecution(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) error {
+	for i := range mh {
+		txHooks, ok := mh[i].(types.EvmTxHooks)
+		if !ok {
+			continue
+		}
+		if err := txHooks.PostTxExecution(ctx, msg, res); err != nil {
+			return errorsmod.Wrapf(err, "EVM hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/evmos/ethermint/x/evm/keeper"
 	"github.com/evmos/ethermint/x/evm/statedb"
@@ -61,7 +62,7 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 	for _, tc := range testCases {
 		suite.SetupTest()
 		hook := tc.setupHook()
-		suite.app.EvmKeeper.SetHooks(keeper.NewMultiEvmHooks(hook))
+		suite.app.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(hook))
 
 		k := suite.app.EvmKeeper
 		ctx := suite.ctx
@@ -87,3 +88,123 @@ func (suite *KeeperTestSuite) TestEvmHooks() {
 		tc.expFunc(hook, result)
 	}
 }
+
+// TxRecordHook records the execution of the txs and creates an account on post execution
+type TxRecordHook struct {
+	ak        types.AccountKeeper
+	account   sdk.AccAddress
+	preCalls  int
+	results   []*types.MsgEthereumTxResponse
+	preErr    error
+	failAfter bool
+}
+
+func (h *TxRecordHook) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return nil
+}
+
+func (h *TxRecordHook) PreTxExecution(ctx sdk.Context, msg core.Message) error {
+	h.preCalls++
+	return h.preErr
+}
+
+func (h *TxRecordHook) PostTxExecution(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) error {
+	h.results = append(h.results, res)
+	h.ak.SetAccount(ctx, h.ak.NewAccountWithAddress(ctx, h.account))
+	if h.failAfter && !res.Failed() {
+		return errors.New("post tx execution failed")
+	}
+	return nil
+}
+
+func (suite *KeeperTestSuite) TestEvmTxHooks() {
+	ethSigner := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+
+	// a value transfer succeeds, a contract creation with the INVALID opcode fails consuming all the gas
+	transferTx := &ethtypes.LegacyTx{GasPrice: big.NewInt(1), Gas: 21000, To: &common.Address{}, Value: big.NewInt(0)}
+	invalidTx := &ethtypes.LegacyTx{GasPrice: big.NewInt(1), Gas: 100000, Value: big.NewInt(0), Data: []byte{0xfe}}
+
+	testCases := []struct {
+		msg        string
+		txData     ethtypes.TxData
+		hook       *TxRecordHook
+		expFailed  bool
+		expVMError error
+		expAccount bool
+	}{
+		{"successful tx", transferTx, &TxRecordHook{}, false, nil, true},
+		{"failed tx", invalidTx, &TxRecordHook{}, true, nil, false},
+		{"pre execution error fails the tx", transferTx, &TxRecordHook{preErr: errors.New("rejected")}, true, types.ErrPreTxExecution, false},
+		{"post execution error reverts the tx", transferTx, &TxRecordHook{failAfter: true}, true, types.ErrPostTxProcessing, false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+			tc.hook.ak = suite.app.AccountKeeper
+			tc.hook.account = sdk.AccAddress(common.BigToAddress(big.NewInt(100)).Bytes())
+			suite.app.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(tc.hook))
+
+			tx, err := newSignedEthTx(tc.txData,
+				suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				sdk.AccAddress(suite.address.Bytes()),
+				suite.signer,
+				ethSigner,
+			)
+			suite.Require().NoError(err)
+
+			res, err := suite.app.EvmKeeper.ApplyTransaction(suite.ctx, tx)
+			suite.Require().Equal(1, tc.hook.preCalls)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expFailed, res.Failed())
+			if tc.expVMError != nil {
+				suite.Require().Equal(tc.expVMError.Error(), res.VmError)
+			}
+
+			last := tc.hook.results[len(tc.hook.results)-1]
+			suite.Require().Equal(res.Failed(), last.Failed())
+			suite.Require().Equal(res.GasUsed, last.GasUsed)
+			suite.Require().Equal(tc.expAccount, suite.app.AccountKeeper.HasAccount(suite.ctx, tc.hook.account))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEventLogRouter() {
+	suite.SetupTest()
+
+	// the contract address is known before deployment to register the route
+	nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+	contractAddr := crypto.CreateAddress(suite.address, nonce)
+
+	var transfers []map[string]interface{}
+	router := keeper.NewEventLogRouter().
+		AddRoute(contractAddr, types.ERC20Contract.ABI, "Transfer",
+			func(ctx sdk.Context, msg core.Message, log *ethtypes.Log, args map[string]interface{}) error {
+				transfers = append(transfers, args)
+				return nil
+			})
+	suite.app.EvmKeeper.CleanHooks().SetHooks(keeper.NewMultiEvmHooks(router))
+
+	suite.Require().True(router.HasRoute(contractAddr, types.ERC20Contract.ABI.Events["Transfer"].ID))
+	suite.Require().False(router.HasRoute(suite.address, types.ERC20Contract.ABI.Events["Transfer"].ID))
+
+	suite.Require().Panics(func() {
+		router.AddRoute(contractAddr, types.ERC20Contract.ABI, "Transfer", nil)
+	})
+	suite.Require().Panics(func() {
+		router.AddRoute(contractAddr, types.ERC20Contract.ABI, "Unknown", nil)
+	})
+
+	supply := big.NewInt(1000)
+	suite.Require().Equal(contractAddr, suite.DeployTestContract(suite.T(), suite.address, supply))
+
+	// the test contract mints the supply without event
+	suite.Require().Empty(transfers)
+
+	to := common.BigToAddress(big.NewInt(1))
+	suite.TransferERC20Token(suite.T(), contractAddr, suite.address, to, big.NewInt(10))
+	suite.Require().Len(transfers, 1)
+	suite.Require().Equal(suite.address, transfers[0]["from"])
+	suite.Require().Equal(to, transfers[0]["to"])
+	suite.Require().Equal(big.NewInt(10), transfers[0]["value"])
+}
@@ -71,6 +71,9 @@ type Keeper struct {
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
 
+	// price oracle of the alternative fee denoms without a static conversion rate
+	feeDenomOracle types.FeeDenomOracle
+
 	// custom stateless precompiled smart contracts
 	customPrecompiles evm.PrecompiledContracts
 
@@ -259,6 +262,24 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// CleanHooks resets the evm hooks set during initialization, so that new ones can be set.
+// It should be only used in tests.
+func (k *Keeper) CleanHooks() *Keeper {
+	k.hooks = nil
+	return k
+}
+
+// SetFeeDenomOracle sets the price oracle of the alternative fee denoms.
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetFeeDenomOracle(oracle types.FeeDenomOracle) *Keeper {
+	if k.feeDenomOracle != nil {
+		panic("cannot set fee denom oracle twice")
+	}
+
+	k.feeDenomOracle = oracle
+	return k
+}
+
 // PostTxProcessing delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
 func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
 	if k.hooks == nil {
@@ -267,6 +288,26 @@ func (k *Keeper) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *et
 	return k.hooks.PostTxProcessing(ctx, msg, receipt)
 }
 
+// PreTxExecution delegate the call to the hooks if they implement EvmTxHooks, otherwise this function
+// returns with a `nil` error
+func (k *Keeper) PreTxExecution(ctx sdk.Context, msg core.Message) error {
+	txHooks, ok := k.hooks.(types.EvmTxHooks)
+	if !ok {
+		return nil
+	}
+	return txHooks.PreTxExecution(ctx, msg)
+}
+
+// PostTxExecution delegate the call to the hooks if they implement EvmTxHooks, otherwise this function
+// returns with a `nil` error
+func (k *Keeper) PostTxExecution(ctx sdk.Context, msg core.Message, res *types.MsgEthereumTxResponse) error {
+	txHooks, ok := k.hooks.(types.EvmTxHooks)
+	if !ok {
+		return nil
+	}
+	return txHooks.PostTxExecution(ctx, msg, res)
+}
+
 // Tracer return a default vm.Tracer based on current keeper state
 func (k Keeper) Tracer(ctx sdk.Context, msg core.Message, ethCfg *params.ChainConfig) vm.EVMLogger {
 	return types.NewTracer(k.tracer, msg, ethCfg, ctx.BlockHeight())
@@ -393,3 +434,60 @@ func (k Keeper) AddTransientGasUsed(ctx sdk.Context, gasUsed uint64) (uint64, er
 	k.SetTransientGasUsed(ctx, result)
 	return result, nil
 }
+
+// GetTransientSenderGasUsed returns the gas consumed in the current block by the transactions of the sender.
+func (k Keeper) GetTransientSenderGasUsed(ctx sdk.Context, sender common.Address) uint64 {
+	return k.getTransientGas(ctx, types.SenderGasUsedTransientKey(sender))
+}
+
+// GetTransientContractGasUsed returns the gas consumed in the current block by the transactions calling
+// the contract.
+func (k Keeper) GetTransientContractGasUsed(ctx sdk.Context, contract common.Address) uint64 {
+	return k.getTransientGas(ctx, types.ContractGasUsedTransientKey(contract))
+}
+
+// AddTransientQuotaGasUsed accumulates the gas used by an eth msg against the per block quotas of its
+// sender and called contract. The gas is only tracked for the quotas enabled in the params.
+func (k Keeper) AddTransientQuotaGasUsed(ctx sdk.Context, params types.Params, msg core.Message, gasUsed uint64) error {
+	if params.MaxBlockGasPerSender > 0 {
+		if err := k.addTransientGas(ctx, types.SenderGasUsedTransientKey(msg.From()), gasUsed); err != nil {
+			return errorsmod.Wrap(err, "transient sender gas used")
+		}
+	}
+
+	if params.MaxBlockGasPerContract > 0 && msg.To() != nil {
+		if err := k.addTransientGas(ctx, types.ContractGasUsedTransientKey(*msg.To()), gasUsed); err != nil {
+			return errorsmod.Wrap(err, "transient contract gas used")
+		}
+	}
+
+	return nil
+}
+
+// SetTxGasQuotaExceededTransient marks the Ethereum transaction as exceeding the block gas quotas, so that
+// it's failed without being executed after paying its fees.
+func (k Keeper) SetTxGasQuotaExceededTransient(ctx sdk.Context, txHash common.Hash) {
+	ctx.TransientStore(k.transientKey).Set(types.TxGasQuotaExceededTransientKey(txHash), []byte{1})
+}
+
+// IsTxGasQuotaExceededTransient returns true if the Ethereum transaction exceeds the block gas quotas.
+func (k Keeper) IsTxGasQuotaExceededTransient(ctx sdk.Context, txHash common.Hash) bool {
+	return ctx.TransientStore(k.transientKey).Has(types.TxGasQuotaExceededTransientKey(txHash))
+}
+
+func (k Keeper) getTransientGas(ctx sdk.Context, key []byte) uint64 {
+	bz := ctx.TransientStore(k.transientKey).Get(key)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) addTransientGas(ctx sdk.Context, key []byte, gasUsed uint64) error {
+	result := k.getTransientGas(ctx, key) + gasUsed
+	if result < gasUsed {
+		return types.ErrGasOverflow
+	}
+	ctx.TransientStore(k.transientKey).Set(key, sdk.Uint64ToBigEndian(result))
+	return nil
+}
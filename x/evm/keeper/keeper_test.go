@@ -40,6 +40,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
@@ -534,3 +535,66 @@ func (suite *KeeperTestSuite) TestGetAccountOrEmpty() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestAddTransientQuotaGasUsed() {
+	sender := tests.GenerateAddress()
+	contract := tests.GenerateAddress()
+	msg := ethtypes.NewMessage(sender, &contract, 0, nil, 0, nil, nil, nil, nil, nil, false)
+	deploy := ethtypes.NewMessage(sender, nil, 0, nil, 0, nil, nil, nil, nil, nil, false)
+
+	testCases := []struct {
+		name           string
+		maxPerSender   uint64
+		maxPerContract uint64
+		msgs           []core.Message
+		expSender      uint64
+		expContract    uint64
+	}{
+		{"quotas disabled", 0, 0, []core.Message{msg, msg}, 0, 0},
+		{"sender quota", 1, 0, []core.Message{msg, deploy}, 2000, 0},
+		{"contract quota", 0, 1, []core.Message{msg, deploy}, 0, 1000},
+		{"both quotas", 1, 1, []core.Message{msg, msg}, 2000, 2000},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.MaxBlockGasPerSender = tc.maxPerSender
+			params.MaxBlockGasPerContract = tc.maxPerContract
+
+			for _, msg := range tc.msgs {
+				err := suite.app.EvmKeeper.AddTransientQuotaGasUsed(suite.ctx, params, msg, 1000)
+				suite.Require().NoError(err)
+			}
+
+			suite.Require().Equal(tc.expSender, suite.app.EvmKeeper.GetTransientSenderGasUsed(suite.ctx, sender))
+			suite.Require().Equal(tc.expContract, suite.app.EvmKeeper.GetTransientContractGasUsed(suite.ctx, contract))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestApplyTransactionGasQuotaExceeded() {
+	suite.SetupTest()
+	ethSigner := ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID())
+	recipient := tests.GenerateAddress()
+
+	txData := &ethtypes.LegacyTx{GasPrice: big.NewInt(1), Gas: 50000, To: &recipient, Value: big.NewInt(100)}
+	tx, err := newSignedEthTx(txData,
+		suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+		sdk.AccAddress(suite.address.Bytes()),
+		suite.signer,
+		ethSigner,
+	)
+	suite.Require().NoError(err)
+
+	suite.app.EvmKeeper.SetTxGasQuotaExceededTransient(suite.ctx, tx.Hash())
+
+	res, err := suite.app.EvmKeeper.ApplyTransaction(suite.ctx, tx)
+	suite.Require().NoError(err)
+	suite.Require().True(res.Failed())
+	suite.Require().Equal(types.ErrGasQuotaExceeded.Error(), res.VmError)
+	suite.Require().Equal(txData.Gas, res.GasUsed)
+	suite.Require().Zero(suite.app.EvmKeeper.GetBalance(suite.ctx, recipient).Sign())
+}
@@ -0,0 +1,139 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+var _ types.EvmHooks = &EventLogRouter{}
+
+// EventLogHandler handles an event log emitted by a successful tx, the args contains the decoded
+// indexed and non-indexed event arguments by name. If it returns an error, the whole transaction
+// is reverted.
+type EventLogHandler func(ctx sdk.Context, msg core.Message, log *ethtypes.Log, args map[string]interface{}) error
+
+type eventLogKey struct {
+	address common.Address
+	topic   common.Hash
+}
+
+type eventLogRoute struct {
+	event   abi.Event
+	handler EventLogHandler
+}
+
+// EventLogRouter is an evm hook dispatching the event logs to the handlers registered by contract
+// address and event signature (topic0), so the modules don't need to scan every receipt log.
+// It can be combined with other evm hooks with NewMultiEvmHooks.
+type EventLogRouter struct {
+	routes map[eventLogKey]eventLogRoute
+}
+
+// NewEventLogRouter creates an empty event log router
+func NewEventLogRouter() *EventLogRouter {
+	return &EventLogRouter{
+		routes: make(map[eventLogKey]eventLogRoute),
+	}
+}
+
+// AddRoute registers the handler of the event with the name in the contract ABI, emitted by the
+// contract at the address. It should be called only during initialization, it panics if the event
+// is not in the ABI, is anonymous or if a handler is already registered for the same event.
+func (r *EventLogRouter) AddRoute(
+	address common.Address,
+	contractABI abi.ABI,
+	eventName string,
+	handler EventLogHandler,
+) *EventLogRouter {
+	event, ok := contractABI.Events[eventName]
+	if !ok {
+		panic(fmt.Sprintf("event %s not found in contract ABI", eventName))
+	}
+
+	if event.Anonymous {
+		panic(fmt.Sprintf("cannot route anonymous event %s", eventName))
+	}
+
+	key := eventLogKey{address: address, topic: event.ID}
+	if _, ok := r.routes[key]; ok {
+		panic(fmt.Sprintf("event log route for %s of %s has already been registered", event.Sig, address))
+	}
+
+	r.routes[key] = eventLogRoute{event: event, handler: handler}
+	return r
+}
+
+// HasRoute returns true if a handler is registered for the event topic emitted by the address.
+func (r *EventLogRouter) HasRoute(address common.Address, topic common.Hash) bool {
+	_, ok := r.routes[eventLogKey{address: address, topic: topic}]
+	return ok
+}
+
+// PostTxProcessing implements EvmHooks, it decodes the receipt logs with a registered route and
+// calls their handlers in the log order.
+func (r *EventLogRouter) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	for _, log := range receipt.Logs {
+		if len(log.Topics) == 0 {
+			continue
+		}
+
+		route, ok := r.routes[eventLogKey{address: log.Address, topic: log.Topics[0]}]
+		if !ok {
+			continue
+		}
+
+		args, err := decodeEventLog(route.event, log)
+		if err != nil {
+			return errorsmod.Wrapf(err, "failed to decode event log %s of %s", route.event.Sig, log.Address)
+		}
+
+		if err := route.handler(ctx, msg, log, args); err != nil {
+			return errorsmod.Wrapf(err, "event log handler %s of %s failed", route.event.Sig, log.Address)
+		}
+	}
+	return nil
+}
+
+// decodeEventLog decodes the indexed arguments from the log topics and the non-indexed ones from
+// the log data.
+func decodeEventLog(event abi.Event, log *ethtypes.Log) (map[string]interface{}, error) {
+	args := make(map[string]interface{}, len(event.Inputs))
+	if err := event.Inputs.UnpackIntoMap(args, log.Data); err != nil {
+		return nil, err
+	}
+
+	var indexed abi.Arguments
+	for _, input := range event.Inputs {
+		if input.Indexed {
+			indexed = append(indexed, input)
+		}
+	}
+
+	if err := abi.ParseTopicsIntoMap(args, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	return args, nil
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/evmos/ethermint/x/evm/types"
 )
@@ -109,6 +110,10 @@ func (k *Keeper) EthereumTx(goCtx context.Context, msg *types.MsgEthereumTx) (*t
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyRecipient, to.Hex()))
 	}
 
+	if payer, found := k.GetTxFeePayerTransient(ctx, tx.Hash()); found {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyFeePayer, payer.Hex()))
+	}
+
 	if response.Failed() {
 		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyEthereumTxFailed, response.VmError))
 	}
@@ -159,3 +164,48 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// SetSystemContract implements the gRPC MsgServer interface. When a SetSystemContract
+// proposal passes, it deploys or replaces the code and storage of the system contract.
+// The update can only be performed if the requested authority is the Cosmos SDK
+// governance module account.
+func (k *Keeper) SetSystemContract(goCtx context.Context, req *types.MsgSetSystemContract) (*types.MsgSetSystemContractResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	codeHash, err := k.DeploySystemContract(ctx, req.Contract, req.ResetStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSystemContract,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, common.HexToAddress(req.Contract.Address).Hex()),
+			sdk.NewAttribute(types.AttributeKeyCodeHash, codeHash.Hex()),
+		),
+	)
+
+	return &types.MsgSetSystemContractResponse{}, nil
+}
+
+// UpdateBlockedAddresses implements the gRPC MsgServer interface. When an UpdateBlockedAddresses
+// proposal passes, it adds and removes the addresses from the blocklist. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module account.
+func (k *Keeper) UpdateBlockedAddresses(goCtx context.Context, req *types.MsgUpdateBlockedAddresses) (*types.MsgUpdateBlockedAddressesResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, address := range req.Block {
+		k.SetBlockedAddress(ctx, common.HexToAddress(address))
+	}
+	for _, address := range req.Unblock {
+		k.DeleteBlockedAddress(ctx, common.HexToAddress(address))
+	}
+
+	return &types.MsgUpdateBlockedAddressesResponse{}, nil
+}
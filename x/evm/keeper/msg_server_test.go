@@ -3,9 +3,11 @@ package keeper_test
 import (
 	"math/big"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/x/evm/statedb"
@@ -115,3 +117,140 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestSetSystemContract() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	address := common.BigToAddress(big.NewInt(0x1000))
+	key1, key2 := common.BigToHash(big.NewInt(1)), common.BigToHash(big.NewInt(2))
+	value := common.BigToHash(big.NewInt(100))
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgSetSystemContract
+		expectErr bool
+		expState  map[common.Hash]common.Hash
+	}{
+		{
+			name: "fail - invalid authority",
+			request: &types.MsgSetSystemContract{
+				Authority: "foobar",
+				Contract:  types.SystemContract{Address: address.Hex(), Code: "6001"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - invalid code",
+			request: &types.MsgSetSystemContract{
+				Authority: authority,
+				Contract:  types.SystemContract{Address: address.Hex(), Code: "zz"},
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - replace code and keep storage",
+			request: &types.MsgSetSystemContract{
+				Authority: authority,
+				Contract: types.SystemContract{
+					Address: address.Hex(),
+					Code:    "6001",
+					Storage: types.Storage{{Key: key2.Hex(), Value: value.Hex()}},
+				},
+			},
+			expState: map[common.Hash]common.Hash{key1: value, key2: value},
+		},
+		{
+			name: "pass - replace code and reset storage",
+			request: &types.MsgSetSystemContract{
+				Authority: authority,
+				Contract: types.SystemContract{
+					Address: address.Hex(),
+					Code:    "6001",
+					Storage: types.Storage{{Key: key2.Hex(), Value: value.Hex()}},
+				},
+				ResetStorage: true,
+			},
+			expState: map[common.Hash]common.Hash{key1: {}, key2: value},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			// initial deployment
+			_, err := suite.app.EvmKeeper.DeploySystemContract(suite.ctx, types.SystemContract{
+				Address: address.Hex(),
+				Code:    "6000",
+				Storage: types.Storage{{Key: key1.Hex(), Value: value.Hex()}},
+			}, false)
+			suite.Require().NoError(err)
+
+			_, err = suite.app.EvmKeeper.SetSystemContract(sdk.WrapSDKContext(suite.ctx), tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			vmdb := suite.StateDB()
+			suite.Require().Equal(common.FromHex(tc.request.Contract.Code), vmdb.GetCode(address))
+			for key, expValue := range tc.expState {
+				suite.Require().Equal(expValue, vmdb.GetState(address, key))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateBlockedAddresses() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	addr1 := common.BigToAddress(big.NewInt(1))
+	addr2 := common.BigToAddress(big.NewInt(2))
+
+	testCases := []struct {
+		name       string
+		request    *types.MsgUpdateBlockedAddresses
+		expectErr  bool
+		expBlocked []common.Address
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateBlockedAddresses{Authority: "foobar", Block: []string{addr2.Hex()}},
+			expectErr: true,
+		},
+		{
+			name:       "pass - block address",
+			request:    &types.MsgUpdateBlockedAddresses{Authority: authority, Block: []string{addr2.Hex()}},
+			expBlocked: []common.Address{addr1, addr2},
+		},
+		{
+			name:       "pass - unblock address",
+			request:    &types.MsgUpdateBlockedAddresses{Authority: authority, Unblock: []string{addr1.Hex()}},
+			expBlocked: []common.Address{},
+		},
+		{
+			name: "pass - block and unblock addresses",
+			request: &types.MsgUpdateBlockedAddresses{
+				Authority: authority,
+				Block:     []string{addr2.Hex()},
+				Unblock:   []string{addr1.Hex()},
+			},
+			expBlocked: []common.Address{addr2},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.SetBlockedAddress(suite.ctx, addr1)
+
+			_, err := suite.app.EvmKeeper.UpdateBlockedAddresses(sdk.WrapSDKContext(suite.ctx), tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().ElementsMatch(tc.expBlocked, suite.app.EvmKeeper.GetBlockedAddresses(suite.ctx))
+		})
+	}
+}
@@ -16,11 +16,13 @@
 package keeper
 
 import (
+	"errors"
 	"math/big"
 
 	tmtypes "github.com/tendermint/tendermint/types"
 
 	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	ethermint "github.com/evmos/ethermint/types"
@@ -54,7 +56,7 @@ func (k *Keeper) NewEVM(
 ) evm.EVM {
 	blockCtx := vm.BlockContext{
 		CanTransfer: core.CanTransfer,
-		Transfer:    core.Transfer,
+		Transfer:    k.Transfer(ctx),
 		GetHash:     k.GetHashFn(ctx),
 		Coinbase:    cfg.CoinBase,
 		GasLimit:    ethermint.BlockGasLimit(ctx),
@@ -175,11 +177,31 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		// thus restricted to be used only inside `ApplyMessage`.
 		tmpCtx, commit = ctx.CacheContext()
 	}
-
-	// pass true to commit the StateDB
-	res, err := k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+	// mark the context so that the module calls performed by the hooks don't emit transaction events
+	tmpCtx = tmpCtx.WithValue(ethTxContextKey{}, true)
+
+	// the tx exceeding the block gas quotas or rejected by the pre execution hooks consumes all its
+	// gas without being executed, its fees and nonce increment from the ante handler are kept
+	var res *types.MsgEthereumTxResponse
+	if k.IsTxGasQuotaExceededTransient(ctx, txConfig.TxHash) {
+		res = &types.MsgEthereumTxResponse{
+			Hash:    txConfig.TxHash.Hex(),
+			GasUsed: msg.Gas(),
+			VmError: types.ErrGasQuotaExceeded.Error(),
+		}
+	} else if err := k.PreTxExecution(tmpCtx, msg); err != nil {
+		k.Logger(ctx).Error("tx pre execution failed", "error", err)
+		res = &types.MsgEthereumTxResponse{
+			Hash:    txConfig.TxHash.Hex(),
+			GasUsed: msg.Gas(),
+			VmError: types.ErrPreTxExecution.Error(),
+		}
+	} else {
+		// pass true to commit the StateDB
+		res, err = k.ApplyMessageWithConfig(tmpCtx, msg, nil, true, cfg, txConfig)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to apply ethereum core message")
+		}
 	}
 
 	logs := types.LogsToEthereum(res.Logs)
@@ -222,7 +244,13 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	if !res.Failed() {
 		receipt.Status = ethtypes.ReceiptStatusSuccessful
 		// Only call hooks if tx executed successfully.
-		if err = k.PostTxProcessing(tmpCtx, msg, receipt); err != nil {
+		if err = k.PostTxProcessing(tmpCtx, msg, receipt); err == nil && k.hooks != nil {
+			// Since the post-processing can alter the log, the post execution hooks need the updated result
+			res.Logs = types.NewLogsFromEth(receipt.Logs)
+			err = k.PostTxExecution(tmpCtx, msg, res)
+		}
+
+		if err != nil {
 			// If hooks return error, revert the whole tx.
 			res.VmError = types.ErrPostTxProcessing.Error()
 			k.Logger(ctx).Error("tx post processing failed", "error", err)
@@ -232,17 +260,47 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		} else if commit != nil {
 			// PostTxProcessing is successful, commit the tmpCtx
 			commit()
-			// Since the post-processing can alter the log, we need to update the result
-			res.Logs = types.NewLogsFromEth(receipt.Logs)
 			ctx.EventManager().EmitEvents(tmpCtx.EventManager().Events())
 		}
+	} else if commit != nil {
+		// the state of the failed execution is discarded, but its events are kept as without hooks
+		ctx.EventManager().EmitEvents(tmpCtx.EventManager().Events())
+	}
+
+	if res.Failed() && k.hooks != nil {
+		// The failed tx is observed on a discarded cache context, the hooks can't alter the state.
+		observeCtx, _ := ctx.CacheContext()
+		if err := k.PostTxExecution(observeCtx, msg, res); err != nil {
+			k.Logger(ctx).Error("failed tx post execution hooks failed", "error", err)
+		}
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+	payer := msg.From()
+	if feePayer, found := k.GetTxFeePayerTransient(ctx, txConfig.TxHash); found {
+		payer = feePayer
+	}
+	fees, feesInFeeDenom := k.GetTxFeesTransient(ctx, txConfig.TxHash)
+	if feesInFeeDenom {
+		err = k.RefundGasInFeeDenom(ctx, msg, payer, msg.Gas()-res.GasUsed, fees)
+	} else {
+		err = k.RefundGasToPayer(ctx, msg, payer, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom)
+	}
+	if err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
 	}
 
+	// record the base fee portion of the fees, distributed by the fee market at the end of the block
+	if cfg.BaseFee != nil && cfg.BaseFee.Sign() > 0 {
+		if !feesInFeeDenom {
+			fees = sdk.NewCoin(cfg.Params.EvmDenom, sdkmath.NewIntFromBigInt(msg.GasPrice()).MulRaw(int64(msg.Gas())))
+		}
+		k.feeMarketKeeper.AddTransientBaseFees(ctx, BaseFeePortion(msg, res.GasUsed, cfg.BaseFee, fees))
+	}
+
+	// record the effective tip paid, tracked by the fee market min gas price oracle
+	k.feeMarketKeeper.AddTransientTip(ctx, EffectiveTip(msg, cfg.BaseFee))
+
 	if len(receipt.Logs) > 0 {
 		// Update transient block bloom filter
 		k.SetBlockBloomTransient(ctx, receipt.Bloom.Big())
@@ -256,6 +314,10 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 		return nil, errorsmod.Wrap(err, "failed to add transient gas used")
 	}
 
+	if err := k.AddTransientQuotaGasUsed(ctx, cfg.Params, msg, res.GasUsed); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to add transient quota gas used")
+	}
+
 	// reset the gas meter for current cosmos transaction
 	k.ResetGasMeterAndConsumeGas(ctx, totalGasUsed)
 	return res, nil
@@ -329,7 +391,19 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
+	// the transaction origin is the deployer of all the contracts created within the transaction
+	if msg.To() == nil && !cfg.Params.IsDeployerAllowed(msg.From()) {
+		return nil, errorsmod.Wrapf(types.ErrCreateNotAllowed, "deployer %s is not allowed", msg.From())
+	}
+
 	stateDB := statedb.New(ctx, k, txConfig)
+	if cfg.Params.HasCreateAllowlist() {
+		origin := msg.From()
+		stateDB.SetCreateValidator(func(code []byte) error {
+			return cfg.Params.ValidateCreate(origin, crypto.Keccak256Hash(code))
+		})
+	}
+
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	leftoverGas := msg.Gas()
@@ -366,17 +440,39 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		stateDB.PrepareAccessList(msg.From(), msg.To(), evm.ActivePrecompiles(rules), msg.AccessList())
 	}
 
+	snapshot := stateDB.Snapshot()
 	if contractCreation {
 		// take over the nonce management from evm:
 		// - reset sender's nonce to msg.Nonce() before calling evm.
 		// - increase sender's nonce by one no matter the result.
 		stateDB.SetNonce(sender.Address(), msg.Nonce())
 		ret, _, leftoverGas, vmErr = evm.Create(sender, msg.Data(), leftoverGas, msg.Value())
-		stateDB.SetNonce(sender.Address(), msg.Nonce()+1)
 	} else {
 		ret, leftoverGas, vmErr = evm.Call(sender, *msg.To(), msg.Data(), leftoverGas, msg.Value())
 	}
 
+	// a contract deployment rejected by the allowlist parameters or a value transfer rejected by
+	// the blocklist reverts the whole execution
+	if err := stateDB.RejectionError(); err != nil && vmErr == nil {
+		stateDB.RevertToSnapshot(snapshot)
+		ret, vmErr = nil, err
+
+		var blockedErr types.BlockedAddressError
+		if errors.As(err, &blockedErr) {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBlockedAddress,
+					sdk.NewAttribute(types.AttributeKeyAddress, blockedErr.Address.Hex()),
+					sdk.NewAttribute(types.AttributeKeyEthereumTxHash, txConfig.TxHash.Hex()),
+				),
+			)
+		}
+	}
+
+	if contractCreation {
+		stateDB.SetNonce(sender.Address(), msg.Nonce()+1)
+	}
+
 	refundQuotient := params.RefundQuotient
 
 	// After EIP-3529: refunds are capped to gasUsed / 5
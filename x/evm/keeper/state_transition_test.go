@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/evmos/ethermint/tests"
 	"github.com/evmos/ethermint/x/evm/keeper"
@@ -532,6 +533,133 @@ func (suite *KeeperTestSuite) TestContractDeployment() {
 	suite.Require().Greater(db.GetCodeSize(contractAddress), 0)
 }
 
+func (suite *KeeperTestSuite) TestContractDeploymentAllowlist() {
+	other := common.BigToAddress(big.NewInt(1))
+
+	testCases := []struct {
+		name       string
+		deployers  func() []string
+		codeHashes func(codeHash common.Hash) []string
+		expErr     error
+	}{
+		{
+			"deployer not allowed",
+			func() []string { return []string{other.Hex()} },
+			func(common.Hash) []string { return nil },
+			types.ErrCreateNotAllowed,
+		},
+		{
+			"code hash not allowed",
+			func() []string { return []string{suite.address.Hex()} },
+			func(common.Hash) []string { return []string{common.Hash{1}.Hex()} },
+			types.ErrVMExecution,
+		},
+		{
+			"deployer and code hash allowed",
+			func() []string { return []string{other.Hex(), suite.address.Hex()} },
+			func(codeHash common.Hash) []string { return []string{codeHash.Hex()} },
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			// deploy the contract once to get the hash of its runtime code
+			contractAddr := suite.DeployTestContract(suite.T(), suite.address, big.NewInt(1000))
+			codeHash := common.BytesToHash(suite.app.EvmKeeper.GetAccount(suite.ctx, contractAddr).CodeHash)
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.AllowedDeployers = tc.deployers()
+			params.AllowedCodeHashes = tc.codeHashes(codeHash)
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			ctorArgs, err := types.ERC20Contract.ABI.Pack("", suite.address, big.NewInt(1000))
+			suite.Require().NoError(err)
+			data := append(types.ERC20Contract.Bin, ctorArgs...)
+
+			nonce := suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address)
+			_, err = suite.app.EvmKeeper.CallEVMWithData(suite.ctx, suite.address, nil, data, true)
+			created := suite.StateDB().GetCodeSize(crypto.CreateAddress(suite.address, nonce)) > 0
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().False(created)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().True(created)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestBlockedAddressTransfer() {
+	recipient := common.BigToAddress(big.NewInt(0x1000))
+	amount := big.NewInt(100)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"not blocked", func() {}, true},
+		{
+			"blocked recipient",
+			func() { suite.app.EvmKeeper.SetBlockedAddress(suite.ctx, recipient) },
+			false,
+		},
+		{
+			"blocked sender",
+			func() { suite.app.EvmKeeper.SetBlockedAddress(suite.ctx, suite.address) },
+			false,
+		},
+		{
+			"unblocked recipient",
+			func() {
+				suite.app.EvmKeeper.SetBlockedAddress(suite.ctx, recipient)
+				suite.app.EvmKeeper.DeleteBlockedAddress(suite.ctx, recipient)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			vmdb := suite.StateDB()
+			vmdb.AddBalance(suite.address, amount)
+			suite.Require().NoError(vmdb.Commit())
+			tc.malleate()
+
+			ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+			nonce := suite.app.EvmKeeper.GetNonce(ctx, suite.address)
+			msg := ethtypes.NewMessage(suite.address, &recipient, nonce, amount, params.TxGas, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true)
+
+			res, err := suite.app.EvmKeeper.ApplyMessage(ctx, msg, nil, true)
+			suite.Require().NoError(err)
+
+			balance := suite.app.EvmKeeper.GetBalance(ctx, recipient)
+			var blockedEvents int
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeBlockedAddress {
+					blockedEvents++
+				}
+			}
+
+			if tc.expPass {
+				suite.Require().False(res.Failed())
+				suite.Require().Equal(amount, balance)
+				suite.Require().Zero(blockedEvents)
+			} else {
+				suite.Require().True(res.Failed())
+				suite.Require().Contains(res.VmError, types.ErrBlockedAddress.Error())
+				suite.Require().Zero(balance.Sign())
+				suite.Require().Equal(1, blockedEvents)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestApplyMessage() {
 	expectedGasUsed := params.TxGas
 	var msg core.Message
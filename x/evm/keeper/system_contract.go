@@ -0,0 +1,73 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	ethermint "github.com/evmos/ethermint/types"
+	"github.com/evmos/ethermint/x/evm/types"
+)
+
+// DeploySystemContract deploys or replaces the code of a system contract at its fixed address and
+// sets the given storage, creating the contract account if it doesn't exist. If resetStorage is
+// true, the existing storage of the contract is deleted beforehand. The nonce and balance of the
+// account are left untouched.
+func (k *Keeper) DeploySystemContract(ctx sdk.Context, contract types.SystemContract, resetStorage bool) (common.Hash, error) {
+	if err := contract.Validate(); err != nil {
+		return common.Hash{}, errorsmod.Wrapf(err, "invalid system contract %s", contract.Address)
+	}
+
+	address := common.HexToAddress(contract.Address)
+	if acct := k.accountKeeper.GetAccount(ctx, address.Bytes()); acct != nil {
+		if _, ok := acct.(ethermint.EthAccountI); !ok {
+			return common.Hash{}, errorsmod.Wrapf(
+				types.ErrInvalidAccount,
+				"system contract %s account must be an EthAccount, got %T", contract.Address, acct,
+			)
+		}
+	}
+
+	code := common.Hex2Bytes(contract.Code)
+	codeHash := crypto.Keccak256Hash(code)
+	k.SetCode(ctx, codeHash.Bytes(), code)
+
+	account := k.GetAccountOrEmpty(ctx, address)
+	account.CodeHash = codeHash.Bytes()
+	if err := k.SetAccount(ctx, address, account); err != nil {
+		return common.Hash{}, errorsmod.Wrapf(err, "failed to set system contract %s account", contract.Address)
+	}
+
+	if resetStorage {
+		var keys []common.Hash
+		k.ForEachStorage(ctx, address, func(key, _ common.Hash) bool {
+			keys = append(keys, key)
+			return true
+		})
+		for _, key := range keys {
+			k.SetState(ctx, address, key, nil)
+		}
+	}
+
+	for _, state := range contract.Storage {
+		k.SetState(ctx, address, common.HexToHash(state.Key), common.HexToHash(state.Value).Bytes())
+	}
+
+	return codeHash, nil
+}
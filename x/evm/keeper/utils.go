@@ -150,3 +150,30 @@ func CheckSenderBalance(
 	}
 	return nil
 }
+
+// CheckSenderValue validates that the tx value is positive and that the sender has enough funds to
+// pay for the value of the transaction, the fees being paid in an alternative fee denom.
+func CheckSenderValue(
+	balance sdkmath.Int,
+	txData types.TxData,
+) error {
+	value := txData.GetValue()
+	if value == nil {
+		return nil
+	}
+
+	if value.Sign() < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInvalidCoins,
+			"tx value (%s) is negative and invalid", value,
+		)
+	}
+
+	if balance.IsNegative() || balance.BigInt().Cmp(value) < 0 {
+		return errorsmod.Wrapf(
+			errortypes.ErrInsufficientFunds,
+			"sender balance < tx value (%s < %s)", balance, value,
+		)
+	}
+	return nil
+}
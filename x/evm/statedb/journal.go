@@ -130,6 +130,8 @@ type (
 		prev uint64
 	}
 	addLogChange struct{}
+	// Rejection of the execution
+	rejectChange struct{}
 
 	// Changes to the access list
 	accessListAddAccountChange struct {
@@ -217,6 +219,14 @@ func (ch addLogChange) Dirtied() *common.Address {
 	return nil
 }
 
+func (ch rejectChange) Revert(s *StateDB) {
+	s.rejectErr = nil
+}
+
+func (ch rejectChange) Dirtied() *common.Address {
+	return nil
+}
+
 func (ch accessListAddAccountChange) Revert(s *StateDB) {
 	/*
 		One important invariant here, is that whenever a (addr, slot) is added, if the
@@ -65,6 +65,11 @@ type StateDB struct {
 
 	// Per-transaction access list
 	accessList *accessList
+
+	// Validates the runtime code of the contracts deployed by the EVM, nil if unrestricted
+	createValidator func(code []byte) error
+	// The first rejection of the execution, reverted along with the rejected state changes
+	rejectErr error
 }
 
 // New creates a new state from a given trie.
@@ -80,6 +85,29 @@ func New(ctx sdk.Context, keeper Keeper, txConfig TxConfig) *StateDB {
 	}
 }
 
+// SetCreateValidator sets the function validating the runtime code of the contracts deployed
+// by the EVM, the rejected deployments are recorded with Reject.
+func (s *StateDB) SetCreateValidator(validator func(code []byte) error) {
+	s.createValidator = validator
+}
+
+// Reject records the first error rejecting the execution. Since the EVM can't be interrupted
+// from the StateDB or the block context functions, the caller of the EVM is responsible for
+// reverting the execution when RejectionError returns an error. The rejection is reverted along
+// with the state changes of the reverted calls.
+func (s *StateDB) Reject(err error) {
+	if s.rejectErr != nil {
+		return
+	}
+	s.journal.append(rejectChange{})
+	s.rejectErr = err
+}
+
+// RejectionError returns the first error rejecting the execution which hasn't been reverted.
+func (s *StateDB) RejectionError() error {
+	return s.rejectErr
+}
+
 // Keeper returns the underlying `Keeper`
 func (s *StateDB) Keeper() Keeper {
 	return s.keeper
@@ -328,6 +356,12 @@ func (s *StateDB) SetNonce(addr common.Address, nonce uint64) {
 
 // SetCode sets the code of account.
 func (s *StateDB) SetCode(addr common.Address, code []byte) {
+	if s.createValidator != nil {
+		if err := s.createValidator(code); err != nil {
+			s.Reject(errorsmod.Wrapf(err, "failed to deploy contract %s", addr))
+		}
+	}
+
 	stateObject := s.getOrNewStateObject(addr)
 	if stateObject != nil {
 		stateObject.SetCode(crypto.Keccak256Hash(code), code)
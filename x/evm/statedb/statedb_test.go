@@ -1,6 +1,7 @@
 package statedb_test
 
 import (
+	"errors"
 	"math/big"
 	"testing"
 
@@ -285,6 +286,32 @@ func (suite *StateDBTestSuite) TestCode() {
 	}
 }
 
+func (suite *StateDBTestSuite) TestCreateValidator() {
+	allowed := []byte("allowed")
+	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+	db.SetCreateValidator(func(code []byte) error {
+		if string(code) != string(allowed) {
+			return errors.New("code not allowed")
+		}
+		return nil
+	})
+
+	db.SetCode(address, allowed)
+	suite.Require().NoError(db.RejectionError())
+
+	// the rejected deployment is reverted along with the error
+	rev := db.Snapshot()
+	db.SetCode(address2, []byte("rejected"))
+	suite.Require().Error(db.RejectionError())
+	db.RevertToSnapshot(rev)
+	suite.Require().NoError(db.RejectionError())
+
+	// the first rejected deployment is kept
+	db.SetCode(address2, []byte("rejected"))
+	db.SetCode(address3, []byte("rejected"))
+	suite.Require().ErrorContains(db.RejectionError(), address2.Hex())
+}
+
 func (suite *StateDBTestSuite) TestRevertSnapshot() {
 	v1 := common.BigToHash(big.NewInt(1))
 	v2 := common.BigToHash(big.NewInt(2))
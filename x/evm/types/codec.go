@@ -38,7 +38,9 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName      = "ethermint/MsgUpdateParams"
+	setSystemContractName = "ethermint/MsgSetSystemContract"
+	updateBlockedAddrName = "ethermint/MsgUpdateBlockedAddresses"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -52,11 +54,14 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*tx.TxExtensionOptionI)(nil),
 		&ExtensionOptionsEthereumTx{},
+		&ExtensionOptionsEthereumTxExpiry{},
 	)
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgSetSystemContract{},
+		&MsgUpdateBlockedAddresses{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -104,4 +109,6 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgSetSystemContract{}, setSystemContractName, nil)
+	cdc.RegisterConcrete(&MsgUpdateBlockedAddresses{}, updateBlockedAddrName, nil)
 }
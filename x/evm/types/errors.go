@@ -49,10 +49,17 @@ const (
 	codeErrGasOverflow
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
+	codeErrCreateNotAllowed
+	codeErrBlockedAddress
+	codeErrInvalidFeeDenom
+	codeErrGasQuotaExceeded
+	codeErrTxExpired
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
 
+var ErrPreTxExecution = errors.New("failed to execute pre tx hooks")
+
 var (
 	// ErrInvalidState returns an error resulting from an invalid Storage State.
 	ErrInvalidState = errorsmod.Register(ModuleName, codeErrInvalidState, "invalid storage state")
@@ -116,6 +123,21 @@ var (
 
 	// ErrInvalidGasLimit returns an error if gas limit value is invalid
 	ErrInvalidGasLimit = errorsmod.Register(ModuleName, codeErrInvalidGasLimit, "invalid gas limit")
+
+	// ErrCreateNotAllowed returns an error if the contract deployment is restricted by the allowlist parameters.
+	ErrCreateNotAllowed = errorsmod.Register(ModuleName, codeErrCreateNotAllowed, "contract deployment not allowed")
+
+	// ErrBlockedAddress returns an error if a blocked address sends or receives EVM value.
+	ErrBlockedAddress = errorsmod.Register(ModuleName, codeErrBlockedAddress, "address is blocked")
+
+	// ErrInvalidFeeDenom returns an error if the fees can't be paid in the given denom.
+	ErrInvalidFeeDenom = errorsmod.Register(ModuleName, codeErrInvalidFeeDenom, "invalid fee denom")
+
+	// ErrGasQuotaExceeded returns an error if a transaction exceeds the per block gas quota of its sender or contract.
+	ErrGasQuotaExceeded = errorsmod.Register(ModuleName, codeErrGasQuotaExceeded, "block gas quota exceeded")
+
+	// ErrTxExpired returns an error if a transaction is included after its expiry block height or time.
+	ErrTxExpired = errorsmod.Register(ModuleName, codeErrTxExpired, "transaction expired")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
@@ -150,3 +172,18 @@ func (e *RevertError) ErrorCode() int {
 func (e *RevertError) ErrorData() interface{} {
 	return e.reason
 }
+
+// BlockedAddressError defines the rejection of a value transfer from or to a blocked address.
+type BlockedAddressError struct {
+	Address common.Address
+}
+
+// Error implements the error interface.
+func (e BlockedAddressError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrBlockedAddress, e.Address)
+}
+
+// Unwrap returns ErrBlockedAddress.
+func (e BlockedAddressError) Unwrap() error {
+	return ErrBlockedAddress
+}
@@ -40,6 +40,22 @@ type Params struct {
 	// allow_unprotected_txs defines if replay-protected (i.e non EIP155
 	// signed) transactions can be executed on the state machine.
 	AllowUnprotectedTxs bool `protobuf:"varint,6,opt,name=allow_unprotected_txs,json=allowUnprotectedTxs,proto3" json:"allow_unprotected_txs,omitempty"`
+	// allowed_deployers defines the hex addresses allowed to deploy contracts as
+	// transaction origin. An empty list allows any address.
+	AllowedDeployers []string `protobuf:"bytes,7,rep,name=allowed_deployers,json=allowedDeployers,proto3" json:"allowed_deployers,omitempty" yaml:"allowed_deployers"`
+	// allowed_code_hashes defines the hex hashes of the runtime code allowed to be
+	// deployed. An empty list allows any code.
+	AllowedCodeHashes []string `protobuf:"bytes,8,rep,name=allowed_code_hashes,json=allowedCodeHashes,proto3" json:"allowed_code_hashes,omitempty" yaml:"allowed_code_hashes"`
+	// fee_denoms defines the alternative coins accepted to pay the fees of the
+	// transactions when the sender cannot afford them in the evm_denom.
+	FeeDenoms []FeeDenom `protobuf:"bytes,9,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms" yaml:"fee_denoms"`
+	// max_block_gas_per_sender defines the maximum gas the transactions of a
+	// single sender can consume per block. Zero disables the quota.
+	MaxBlockGasPerSender uint64 `protobuf:"varint,10,opt,name=max_block_gas_per_sender,json=maxBlockGasPerSender,proto3" json:"max_block_gas_per_sender,omitempty" yaml:"max_block_gas_per_sender"`
+	// max_block_gas_per_contract defines the maximum gas the transactions calling
+	// a single contract (i.e sharing the same to address) can consume per block.
+	// Zero disables the quota.
+	MaxBlockGasPerContract uint64 `protobuf:"varint,11,opt,name=max_block_gas_per_contract,json=maxBlockGasPerContract,proto3" json:"max_block_gas_per_contract,omitempty" yaml:"max_block_gas_per_contract"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +133,91 @@ func (m *Params) GetAllowUnprotectedTxs() bool {
 	return false
 }
 
+func (m *Params) GetAllowedDeployers() []string {
+	if m != nil {
+		return m.AllowedDeployers
+	}
+	return nil
+}
+
+func (m *Params) GetAllowedCodeHashes() []string {
+	if m != nil {
+		return m.AllowedCodeHashes
+	}
+	return nil
+}
+
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBlockGasPerSender() uint64 {
+	if m != nil {
+		return m.MaxBlockGasPerSender
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBlockGasPerContract() uint64 {
+	if m != nil {
+		return m.MaxBlockGasPerContract
+	}
+	return 0
+}
+
+// FeeDenom defines a coin accepted to pay the transaction fees in place of the
+// EVM denomination.
+type FeeDenom struct {
+	// denom of the coin
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// conversion_rate defines the amount of denom units paid per unit of the EVM
+	// denomination. A zero rate uses the rate provided by the price oracle.
+	ConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=conversion_rate,json=conversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_rate" yaml:"conversion_rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -165,7 +266,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -220,7 +321,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -263,6 +364,71 @@ func (m *State) GetValue() string {
 	return ""
 }
 
+// SystemContract defines a chain-owned contract whose code and storage are set at a
+// fixed address, either at genesis or through governance.
+type SystemContract struct {
+	// address defines the ethereum hex formated address of the contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// code defines the hex bytes of the contract runtime code.
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// storage defines the set of state key values of the contract.
+	Storage Storage `protobuf:"bytes,3,rep,name=storage,proto3,castrepeated=Storage" json:"storage"`
+}
+
+func (m *SystemContract) Reset()         { *m = SystemContract{} }
+func (m *SystemContract) String() string { return proto.CompactTextString(m) }
+func (*SystemContract) ProtoMessage()    {}
+func (*SystemContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+}
+func (m *SystemContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SystemContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SystemContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SystemContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SystemContract.Merge(m, src)
+}
+func (m *SystemContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *SystemContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_SystemContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SystemContract proto.InternalMessageInfo
+
+func (m *SystemContract) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *SystemContract) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *SystemContract) GetStorage() Storage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
 // TransactionLogs define the logs generated from a transaction execution
 // with a given hash. It it used for import/export data as transactions are not
 // persisted on blockchain state after an upgrade.
@@ -277,7 +443,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -353,7 +519,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -468,7 +634,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -509,7 +675,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -569,7 +735,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -677,8 +843,10 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "ethermint.evm.v1.FeeDenom")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
+	proto.RegisterType((*SystemContract)(nil), "ethermint.evm.v1.SystemContract")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
 	proto.RegisterType((*Log)(nil), "ethermint.evm.v1.Log")
 	proto.RegisterType((*TxResult)(nil), "ethermint.evm.v1.TxResult")
@@ -689,108 +857,125 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x6e, 0xe3, 0xc6,
-	0x15, 0xb6, 0x2d, 0xda, 0xa6, 0x46, 0xb2, 0x44, 0x8f, 0xb5, 0x8e, 0xb2, 0x8b, 0x9a, 0x2e, 0x2f,
-	0x0a, 0x17, 0x48, 0xec, 0xd8, 0x81, 0xd1, 0x45, 0x82, 0x16, 0xb5, 0x76, 0x9d, 0xc4, 0xee, 0x36,
-	0x35, 0xc6, 0x0e, 0x0a, 0x14, 0x28, 0x88, 0x11, 0x39, 0xa1, 0x18, 0x93, 0x1c, 0x61, 0x66, 0xa8,
-	0x95, 0xda, 0x3e, 0x40, 0x8b, 0xde, 0xf4, 0x09, 0x8a, 0x3c, 0x4e, 0xd0, 0xab, 0xbd, 0x2c, 0x7a,
-	0x41, 0x14, 0xde, 0x3b, 0x5f, 0xea, 0x09, 0x8a, 0xf9, 0x11, 0xf5, 0x63, 0xa3, 0xad, 0x75, 0xe5,
-	0xf9, 0xce, 0x39, 0xf3, 0x7d, 0x33, 0x67, 0xce, 0x68, 0x0e, 0x0d, 0x9e, 0x13, 0xd1, 0x23, 0x2c,
-	0x8d, 0x33, 0x71, 0x44, 0x06, 0xe9, 0xd1, 0xe0, 0x58, 0xfe, 0x39, 0xec, 0x33, 0x2a, 0x28, 0x74,
-	0x4a, 0xdf, 0xa1, 0x34, 0x0e, 0x8e, 0x9f, 0xb7, 0x22, 0x1a, 0x51, 0xe5, 0x3c, 0x92, 0x23, 0x1d,
-	0xe7, 0xfd, 0xa5, 0x02, 0x36, 0xae, 0x30, 0xc3, 0x29, 0x87, 0xc7, 0xa0, 0x4a, 0x06, 0xa9, 0x1f,
-	0x92, 0x8c, 0xa6, 0xed, 0xd5, 0xfd, 0xd5, 0x83, 0x6a, 0xa7, 0x35, 0x2e, 0x5c, 0x67, 0x84, 0xd3,
-	0xe4, 0x33, 0xaf, 0x74, 0x79, 0xc8, 0x26, 0x83, 0xf4, 0xb5, 0x1c, 0xc2, 0x9f, 0x83, 0x2d, 0x92,
-	0xe1, 0x6e, 0x42, 0xfc, 0x80, 0x11, 0x2c, 0x48, 0x7b, 0x6d, 0x7f, 0xf5, 0xc0, 0xee, 0xb4, 0xc7,
-	0x85, 0xdb, 0x32, 0xd3, 0x66, 0xdd, 0x1e, 0xaa, 0x6b, 0xfc, 0x4a, 0x41, 0xf8, 0x33, 0x50, 0x9b,
-	0xf8, 0x71, 0x92, 0xb4, 0x2b, 0x6a, 0xf2, 0xee, 0xb8, 0x70, 0xe1, 0xfc, 0x64, 0x9c, 0x24, 0x1e,
-	0x02, 0x66, 0x2a, 0x4e, 0x12, 0x78, 0x06, 0x00, 0x19, 0x0a, 0x86, 0x7d, 0x12, 0xf7, 0x79, 0xdb,
-	0xda, 0xaf, 0x1c, 0x54, 0x3a, 0xde, 0x5d, 0xe1, 0x56, 0xcf, 0xa5, 0xf5, 0xfc, 0xe2, 0x8a, 0x8f,
-	0x0b, 0x77, 0xdb, 0x90, 0x94, 0x81, 0x1e, 0xaa, 0x2a, 0x70, 0x1e, 0xf7, 0x39, 0xfc, 0x3d, 0xa8,
-	0x07, 0x3d, 0x1c, 0x67, 0x7e, 0x40, 0xb3, 0x6f, 0xe3, 0xa8, 0xbd, 0xbe, 0xbf, 0x7a, 0x50, 0x3b,
-	0xf9, 0xd1, 0xe1, 0x62, 0xde, 0x0e, 0x5f, 0xc9, 0xa8, 0x57, 0x2a, 0xa8, 0xf3, 0xe2, 0x87, 0xc2,
-	0x5d, 0x19, 0x17, 0xee, 0x8e, 0xa6, 0x9e, 0x25, 0xf0, 0x50, 0x2d, 0x98, 0x46, 0xc2, 0x13, 0xf0,
-	0x0c, 0x27, 0x09, 0x7d, 0xeb, 0xe7, 0x99, 0x4c, 0x34, 0x09, 0x04, 0x09, 0x7d, 0x31, 0xe4, 0xed,
-	0x0d, 0xb9, 0x49, 0xb4, 0xa3, 0x9c, 0xdf, 0x4c, 0x7d, 0x37, 0x43, 0xee, 0xfd, 0x7d, 0x1b, 0xd4,
-	0x66, 0xd4, 0x60, 0x0a, 0x9a, 0x3d, 0x9a, 0x12, 0x2e, 0x08, 0x0e, 0xfd, 0x6e, 0x42, 0x83, 0x5b,
-	0x73, 0x2c, 0xaf, 0xff, 0x55, 0xb8, 0x3f, 0x89, 0x62, 0xd1, 0xcb, 0xbb, 0x87, 0x01, 0x4d, 0x8f,
-	0x02, 0xca, 0x53, 0xca, 0xcd, 0x9f, 0x8f, 0x79, 0x78, 0x7b, 0x24, 0x46, 0x7d, 0xc2, 0x0f, 0x2f,
-	0x32, 0x31, 0x2e, 0xdc, 0x5d, 0xbd, 0xd8, 0x05, 0x2a, 0x0f, 0x35, 0x4a, 0x4b, 0x47, 0x1a, 0xe0,
-	0x08, 0x34, 0x42, 0x4c, 0xfd, 0x6f, 0x29, 0xbb, 0x35, 0x6a, 0x6b, 0x4a, 0xed, 0xfa, 0xff, 0x57,
-	0xbb, 0x2b, 0xdc, 0xfa, 0xeb, 0xb3, 0xdf, 0x7c, 0x41, 0xd9, 0xad, 0xe2, 0x1c, 0x17, 0xee, 0x33,
-	0xad, 0x3e, 0xcf, 0xec, 0xa1, 0x7a, 0x88, 0x69, 0x19, 0x06, 0x7f, 0x0b, 0x9c, 0x32, 0x80, 0xe7,
-	0xfd, 0x3e, 0x65, 0xc2, 0x54, 0xc3, 0xc7, 0x77, 0x85, 0xdb, 0x30, 0x94, 0xd7, 0xda, 0x33, 0x2e,
-	0xdc, 0x0f, 0x16, 0x48, 0xcd, 0x1c, 0x0f, 0x35, 0x0c, 0xad, 0x09, 0x85, 0x1c, 0xd4, 0x49, 0xdc,
-	0x3f, 0x3e, 0xfd, 0xc4, 0xec, 0xc8, 0x52, 0x3b, 0xba, 0x7a, 0xd2, 0x8e, 0x6a, 0xe7, 0x17, 0x57,
-	0xc7, 0xa7, 0x9f, 0x4c, 0x36, 0x64, 0xce, 0x7e, 0x96, 0xd6, 0x43, 0x35, 0x0d, 0xf5, 0x6e, 0x2e,
-	0x80, 0x81, 0x7e, 0x0f, 0xf3, 0x9e, 0xaa, 0xac, 0x6a, 0xe7, 0xe0, 0xae, 0x70, 0x81, 0x66, 0xfa,
-	0x0a, 0xf3, 0xde, 0xf4, 0x5c, 0xba, 0xa3, 0x3f, 0xe0, 0x4c, 0xc4, 0x79, 0x3a, 0xe1, 0x02, 0x7a,
-	0xb2, 0x8c, 0x2a, 0xd7, 0x7f, 0x6a, 0xd6, 0xbf, 0xb1, 0xf4, 0xfa, 0x4f, 0x1f, 0x5b, 0xff, 0xe9,
-	0xfc, 0xfa, 0x75, 0x4c, 0x29, 0xfa, 0xd2, 0x88, 0x6e, 0x2e, 0x2d, 0xfa, 0xf2, 0x31, 0xd1, 0x97,
-	0xf3, 0xa2, 0x3a, 0x46, 0x16, 0xfb, 0x42, 0x26, 0xda, 0xf6, 0xf2, 0xc5, 0xfe, 0x20, 0xa9, 0x8d,
-	0xd2, 0xa2, 0xe5, 0xfe, 0x04, 0x5a, 0x01, 0xcd, 0xb8, 0x90, 0xb6, 0x8c, 0xf6, 0x13, 0x62, 0x34,
-	0xab, 0x4a, 0xf3, 0xe2, 0x49, 0x9a, 0x2f, 0xcc, 0xaf, 0xc1, 0x23, 0x7c, 0x1e, 0xda, 0x99, 0x37,
-	0x6b, 0xf5, 0x3e, 0x70, 0xfa, 0x44, 0x10, 0xc6, 0xbb, 0x39, 0x8b, 0x8c, 0x32, 0x50, 0xca, 0xe7,
-	0x4f, 0x52, 0x36, 0xf7, 0x60, 0x91, 0xcb, 0x43, 0xcd, 0xa9, 0x49, 0x2b, 0x7e, 0x07, 0x1a, 0xb1,
-	0x5c, 0x46, 0x37, 0x4f, 0x8c, 0x5e, 0x4d, 0xe9, 0xbd, 0x7a, 0x92, 0x9e, 0xb9, 0xcc, 0xf3, 0x4c,
-	0x1e, 0xda, 0x9a, 0x18, 0xb4, 0x56, 0x0e, 0x60, 0x9a, 0xc7, 0xcc, 0x8f, 0x12, 0x1c, 0xc4, 0x84,
-	0x19, 0xbd, 0xba, 0xd2, 0xfb, 0xf2, 0x49, 0x7a, 0x1f, 0x6a, 0xbd, 0x87, 0x6c, 0x1e, 0x72, 0xa4,
-	0xf1, 0x4b, 0x6d, 0xd3, 0xb2, 0x21, 0xa8, 0x77, 0x09, 0x4b, 0xe2, 0xcc, 0x08, 0x6e, 0x29, 0xc1,
-	0xb3, 0x27, 0x09, 0x9a, 0x3a, 0x9d, 0xe5, 0xf1, 0x50, 0x4d, 0xc3, 0x52, 0x25, 0xa1, 0x59, 0x48,
-	0x27, 0x2a, 0xdb, 0xcb, 0xab, 0xcc, 0xf2, 0x78, 0xa8, 0xa6, 0xa1, 0x56, 0x19, 0x82, 0x1d, 0xcc,
-	0x18, 0x7d, 0xbb, 0x90, 0x43, 0xa8, 0xc4, 0xbe, 0x7a, 0x92, 0xd8, 0x73, 0x2d, 0xf6, 0x08, 0x9d,
-	0x87, 0xb6, 0x95, 0x75, 0x2e, 0x8b, 0x39, 0x80, 0x11, 0xc3, 0xa3, 0x05, 0xe1, 0xd6, 0xf2, 0x87,
-	0xf7, 0x90, 0xcd, 0x43, 0x8e, 0x34, 0xce, 0xc9, 0xfe, 0x11, 0xb4, 0x52, 0xc2, 0x22, 0xe2, 0x67,
-	0x44, 0xf0, 0x7e, 0x12, 0x0b, 0x23, 0xfc, 0x6c, 0xf9, 0xfb, 0xf8, 0x18, 0x9f, 0x87, 0xa0, 0x32,
-	0x7f, 0x6d, 0xac, 0xe5, 0xe5, 0xe0, 0x3d, 0x9c, 0x45, 0x3d, 0x1c, 0x1b, 0xd9, 0xdd, 0xe5, 0x2f,
-	0xc7, 0x3c, 0x93, 0x87, 0xb6, 0x26, 0x86, 0xb2, 0x7e, 0x02, 0x9c, 0x05, 0xf9, 0xa4, 0x7e, 0x3e,
-	0x58, 0xbe, 0x7e, 0x66, 0x79, 0x64, 0xfb, 0xa1, 0xa0, 0x52, 0xb9, 0xb4, 0xec, 0x86, 0xd3, 0xbc,
-	0xb4, 0xec, 0xa6, 0xe3, 0x5c, 0x5a, 0xb6, 0xe3, 0x6c, 0x5f, 0x5a, 0xf6, 0x8e, 0xd3, 0x42, 0x5b,
-	0x23, 0x9a, 0x50, 0x7f, 0xf0, 0xa9, 0x9e, 0x84, 0x6a, 0xe4, 0x2d, 0xe6, 0xe6, 0x37, 0x12, 0x35,
-	0x02, 0x2c, 0x70, 0x32, 0xe2, 0x26, 0x55, 0xc8, 0xd1, 0x09, 0x9c, 0x79, 0xb5, 0x8f, 0xc0, 0xfa,
-	0xb5, 0x90, 0x8d, 0x9b, 0x03, 0x2a, 0xb7, 0x64, 0xa4, 0xbb, 0x11, 0x24, 0x87, 0xb0, 0x05, 0xd6,
-	0x07, 0x38, 0xc9, 0x75, 0x07, 0x58, 0x45, 0x1a, 0x78, 0x57, 0xa0, 0x79, 0xc3, 0x70, 0xc6, 0x71,
-	0x20, 0x62, 0x9a, 0xbd, 0xa1, 0x11, 0x87, 0x10, 0x58, 0xea, 0x55, 0xd4, 0x73, 0xd5, 0x18, 0xfe,
-	0x14, 0x58, 0x09, 0x8d, 0x78, 0x7b, 0x6d, 0xbf, 0x72, 0x50, 0x3b, 0x79, 0xf6, 0xb0, 0x07, 0x7b,
-	0x43, 0x23, 0xa4, 0x42, 0xbc, 0x7f, 0xac, 0x81, 0xca, 0x1b, 0x1a, 0xc1, 0x36, 0xd8, 0xc4, 0x61,
-	0xc8, 0x08, 0xe7, 0x86, 0x69, 0x02, 0xe1, 0x2e, 0xd8, 0x10, 0xb4, 0x1f, 0x07, 0x9a, 0xae, 0x8a,
-	0x0c, 0x92, 0xc2, 0x21, 0x16, 0x58, 0xf5, 0x15, 0x75, 0xa4, 0xc6, 0xf0, 0x04, 0xd4, 0xd5, 0xce,
-	0xfc, 0x2c, 0x4f, 0xbb, 0x84, 0xa9, 0xf6, 0xc0, 0xea, 0x34, 0xef, 0x0b, 0xb7, 0xa6, 0xec, 0x5f,
-	0x2b, 0x33, 0x9a, 0x05, 0xf0, 0x23, 0xb0, 0x29, 0x86, 0xb3, 0x2f, 0xfb, 0xce, 0x7d, 0xe1, 0x36,
-	0xc5, 0x74, 0x9b, 0xf2, 0xe1, 0x46, 0x1b, 0x62, 0xa8, 0x1e, 0xf0, 0x23, 0x60, 0x8b, 0xa1, 0x1f,
-	0x67, 0x21, 0x19, 0xaa, 0xc7, 0xdb, 0xea, 0xb4, 0xee, 0x0b, 0xd7, 0x99, 0x09, 0xbf, 0x90, 0x3e,
-	0xb4, 0x29, 0x86, 0x6a, 0x00, 0x3f, 0x02, 0x40, 0x2f, 0x49, 0x29, 0xe8, 0xa7, 0x77, 0xeb, 0xbe,
-	0x70, 0xab, 0xca, 0xaa, 0xb8, 0xa7, 0x43, 0xe8, 0x81, 0x75, 0xcd, 0x6d, 0x2b, 0xee, 0xfa, 0x7d,
-	0xe1, 0xda, 0x09, 0x8d, 0x34, 0xa7, 0x76, 0xc9, 0x54, 0x31, 0x92, 0xd2, 0x01, 0x09, 0xd5, 0xeb,
-	0x66, 0xa3, 0x09, 0xf4, 0xfe, 0xba, 0x06, 0xec, 0x9b, 0x21, 0x22, 0x3c, 0x4f, 0x04, 0xfc, 0x02,
-	0x38, 0x01, 0xcd, 0x04, 0xc3, 0x81, 0xf0, 0xe7, 0x52, 0xdb, 0x79, 0x31, 0x7d, 0x69, 0x16, 0x23,
-	0x3c, 0xd4, 0x9c, 0x98, 0xce, 0x4c, 0xfe, 0x5b, 0x60, 0xbd, 0x9b, 0x50, 0x9a, 0xaa, 0x4a, 0xa8,
-	0x23, 0x0d, 0x20, 0x52, 0x59, 0x53, 0xa7, 0x5c, 0x51, 0x9d, 0xf6, 0x8f, 0x1f, 0x9e, 0xf2, 0x42,
-	0xa9, 0x74, 0x76, 0x4d, 0xb7, 0xdd, 0xd0, 0xda, 0x66, 0xbe, 0x27, 0x73, 0xab, 0x4a, 0xc9, 0x01,
-	0x15, 0x46, 0x84, 0x3a, 0xb4, 0x3a, 0x92, 0x43, 0xf8, 0x1c, 0xd8, 0x8c, 0x0c, 0x08, 0x13, 0x24,
-	0x54, 0x87, 0x63, 0xa3, 0x12, 0xc3, 0x0f, 0x81, 0x1d, 0x61, 0xee, 0xe7, 0x9c, 0x84, 0xfa, 0x24,
-	0xd0, 0x66, 0x84, 0xf9, 0x37, 0x9c, 0x84, 0x9f, 0x59, 0x7f, 0xfe, 0xde, 0x5d, 0xf1, 0x30, 0xa8,
-	0x9d, 0x05, 0x01, 0xe1, 0xfc, 0x26, 0xef, 0x27, 0xe4, 0xbf, 0x54, 0xd8, 0x09, 0xa8, 0x73, 0x41,
-	0x19, 0x8e, 0x88, 0x7f, 0x4b, 0x46, 0xa6, 0xce, 0x74, 0xd5, 0x18, 0xfb, 0xaf, 0xc8, 0x88, 0xa3,
-	0x59, 0x60, 0x24, 0xbe, 0xb7, 0x40, 0xed, 0x86, 0xe1, 0x80, 0x98, 0x0e, 0x5f, 0xd6, 0xaa, 0x84,
-	0xcc, 0x48, 0x18, 0x24, 0xb5, 0x45, 0x9c, 0x12, 0x9a, 0x0b, 0x73, 0x9f, 0x26, 0x50, 0xce, 0x60,
-	0x84, 0x0c, 0x49, 0xa0, 0xd2, 0x68, 0x21, 0x83, 0xe0, 0x29, 0xd8, 0x0a, 0x63, 0xae, 0x3e, 0x97,
-	0xb8, 0xc0, 0xc1, 0xad, 0xde, 0x7e, 0xc7, 0xb9, 0x2f, 0xdc, 0xba, 0x71, 0x5c, 0x4b, 0x3b, 0x9a,
-	0x43, 0xf0, 0x73, 0xd0, 0x9c, 0x4e, 0x53, 0xab, 0xd5, 0x1f, 0x28, 0x1d, 0x78, 0x5f, 0xb8, 0x8d,
-	0x32, 0x54, 0x79, 0xd0, 0x02, 0x96, 0x27, 0x1d, 0x92, 0x6e, 0x1e, 0xa9, 0xe2, 0xb3, 0x91, 0x06,
-	0xd2, 0x9a, 0xc4, 0x69, 0x2c, 0x54, 0xb1, 0xad, 0x23, 0x0d, 0xe0, 0xe7, 0xa0, 0x4a, 0x07, 0x84,
-	0xb1, 0x38, 0x24, 0x5c, 0xb5, 0x3a, 0xff, 0xeb, 0x5b, 0x0b, 0x4d, 0xe3, 0xe5, 0xe6, 0xcc, 0xa7,
-	0x60, 0x4a, 0x52, 0xca, 0x46, 0xaa, 0x77, 0x31, 0x9b, 0xd3, 0x8e, 0x5f, 0x2b, 0x3b, 0x9a, 0x43,
-	0xb0, 0x03, 0xa0, 0x99, 0xc6, 0x88, 0xc8, 0x59, 0xe6, 0xab, 0xfb, 0x5f, 0x57, 0x73, 0xd5, 0x2d,
-	0xd4, 0x5e, 0xa4, 0x9c, 0xaf, 0xb1, 0xc0, 0xe8, 0x81, 0x05, 0xfe, 0x02, 0x40, 0x7d, 0x26, 0xfe,
-	0x77, 0x9c, 0x96, 0x1f, 0x8b, 0xba, 0xb5, 0x50, 0xfa, 0xda, 0x6b, 0xd6, 0xec, 0x68, 0x74, 0xc9,
-	0xa9, 0xd9, 0xc5, 0xa5, 0x65, 0x5b, 0xce, 0xfa, 0xa5, 0x65, 0x6f, 0x3a, 0x76, 0x99, 0x3f, 0xb3,
-	0x0b, 0xb4, 0x33, 0xc1, 0x33, 0xcb, 0xeb, 0xfc, 0xf2, 0x87, 0xbb, 0xbd, 0xd5, 0x77, 0x77, 0x7b,
-	0xab, 0xff, 0xbe, 0xdb, 0x5b, 0xfd, 0xdb, 0xfb, 0xbd, 0x95, 0x77, 0xef, 0xf7, 0x56, 0xfe, 0xf9,
-	0x7e, 0x6f, 0xe5, 0x77, 0xb3, 0xef, 0x03, 0x19, 0xc8, 0xe7, 0x61, 0xfa, 0xfd, 0x3f, 0x54, 0xff,
-	0x01, 0x50, 0x6f, 0x44, 0x77, 0x43, 0x7d, 0xd9, 0x7f, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xe2, 0xe1, 0x18, 0x7a, 0x1f, 0x10, 0x00, 0x00,
+	// 1876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x6f, 0xdb, 0xc8,
+	0xf9, 0x8f, 0x22, 0xd9, 0xa6, 0x46, 0xb2, 0xc4, 0x8c, 0x15, 0x87, 0x71, 0xfe, 0x7f, 0xd3, 0xcb,
+	0xa2, 0x85, 0x0b, 0xec, 0xda, 0x1b, 0x2f, 0x8c, 0x06, 0xbb, 0x68, 0x51, 0xd3, 0x76, 0xb2, 0x76,
+	0xd3, 0xd4, 0x18, 0x7b, 0x51, 0xa0, 0x45, 0x41, 0x8c, 0xc8, 0x89, 0xcc, 0x35, 0xc9, 0x51, 0x67,
+	0x46, 0x8a, 0xd4, 0xf6, 0xd4, 0x53, 0x81, 0x5e, 0x0a, 0xf4, 0x5e, 0xec, 0xb9, 0x1f, 0xa1, 0x9f,
+	0x60, 0xd1, 0xd3, 0x1e, 0x8b, 0x1e, 0xd8, 0xc2, 0xb9, 0xf9, 0xa8, 0x4f, 0x50, 0xcc, 0x0b, 0xf5,
+	0x9a, 0x5d, 0xc4, 0x3e, 0x71, 0x9e, 0x97, 0xf9, 0xfd, 0x66, 0x9e, 0x79, 0x1e, 0xce, 0x43, 0x82,
+	0x0d, 0x22, 0x2e, 0x09, 0x4b, 0xe3, 0x4c, 0xec, 0x92, 0x7e, 0xba, 0xdb, 0x7f, 0x2a, 0x1f, 0x3b,
+	0x5d, 0x46, 0x05, 0x85, 0xf6, 0xd8, 0xb6, 0x23, 0x95, 0xfd, 0xa7, 0x1b, 0xad, 0x0e, 0xed, 0x50,
+	0x65, 0xdc, 0x95, 0x23, 0xed, 0xe7, 0xfd, 0x63, 0x19, 0x2c, 0x9f, 0x61, 0x86, 0x53, 0x0e, 0x9f,
+	0x82, 0x2a, 0xe9, 0xa7, 0x41, 0x44, 0x32, 0x9a, 0x3a, 0xa5, 0xad, 0xd2, 0x76, 0xd5, 0x6f, 0x8d,
+	0x72, 0xd7, 0x1e, 0xe2, 0x34, 0xf9, 0xd4, 0x1b, 0x9b, 0x3c, 0x64, 0x91, 0x7e, 0x7a, 0x24, 0x87,
+	0xf0, 0xc7, 0x60, 0x95, 0x64, 0xb8, 0x9d, 0x90, 0x20, 0x64, 0x04, 0x0b, 0xe2, 0xdc, 0xdf, 0x2a,
+	0x6d, 0x5b, 0xbe, 0x33, 0xca, 0xdd, 0x96, 0x99, 0x36, 0x6d, 0xf6, 0x50, 0x5d, 0xcb, 0x87, 0x4a,
+	0x84, 0x3f, 0x02, 0xb5, 0xc2, 0x8e, 0x93, 0xc4, 0x29, 0xab, 0xc9, 0xeb, 0xa3, 0xdc, 0x85, 0xb3,
+	0x93, 0x71, 0x92, 0x78, 0x08, 0x98, 0xa9, 0x38, 0x49, 0xe0, 0x01, 0x00, 0x64, 0x20, 0x18, 0x0e,
+	0x48, 0xdc, 0xe5, 0x4e, 0x65, 0xab, 0xbc, 0x5d, 0xf6, 0xbd, 0xeb, 0xdc, 0xad, 0x1e, 0x4b, 0xed,
+	0xf1, 0xc9, 0x19, 0x1f, 0xe5, 0xee, 0x03, 0x03, 0x32, 0x76, 0xf4, 0x50, 0x55, 0x09, 0xc7, 0x71,
+	0x97, 0xc3, 0xdf, 0x80, 0x7a, 0x78, 0x89, 0xe3, 0x2c, 0x08, 0x69, 0xf6, 0x3a, 0xee, 0x38, 0x4b,
+	0x5b, 0xa5, 0xed, 0xda, 0xde, 0xff, 0xef, 0xcc, 0xc7, 0x6d, 0xe7, 0x50, 0x7a, 0x1d, 0x2a, 0x27,
+	0xff, 0xc9, 0xd7, 0xb9, 0x7b, 0x6f, 0x94, 0xbb, 0x6b, 0x1a, 0x7a, 0x1a, 0xc0, 0x43, 0xb5, 0x70,
+	0xe2, 0x09, 0xf7, 0xc0, 0x43, 0x9c, 0x24, 0xf4, 0x4d, 0xd0, 0xcb, 0x64, 0xa0, 0x49, 0x28, 0x48,
+	0x14, 0x88, 0x01, 0x77, 0x96, 0xe5, 0x26, 0xd1, 0x9a, 0x32, 0x7e, 0x31, 0xb1, 0x5d, 0x0c, 0x38,
+	0x3c, 0x01, 0x0f, 0x94, 0x9a, 0x44, 0x41, 0x44, 0xba, 0x09, 0x1d, 0x12, 0xc6, 0x9d, 0x95, 0xad,
+	0xf2, 0x76, 0xd5, 0xff, 0xbf, 0x51, 0xee, 0x3a, 0x9a, 0x74, 0xc1, 0xc5, 0x43, 0xb6, 0xd1, 0x1d,
+	0x15, 0x2a, 0xf8, 0x0a, 0xac, 0x15, 0x7e, 0x21, 0x8d, 0x48, 0x70, 0x89, 0xf9, 0x25, 0xe1, 0x8e,
+	0xa5, 0xc0, 0x36, 0x47, 0xb9, 0xbb, 0x31, 0x0b, 0x36, 0xe5, 0xe4, 0xa1, 0x62, 0x15, 0x87, 0x34,
+	0x22, 0x9f, 0x2b, 0x1d, 0xbc, 0x00, 0xe0, 0x35, 0x21, 0x3a, 0x01, 0xb8, 0x53, 0xdd, 0x2a, 0x6f,
+	0xd7, 0xf6, 0x36, 0x16, 0x63, 0xf5, 0x9c, 0x10, 0x95, 0x18, 0xfe, 0x63, 0x13, 0x28, 0x73, 0x06,
+	0x93, 0xb9, 0x1e, 0xaa, 0xbe, 0x36, 0x4e, 0x1c, 0xfe, 0x1a, 0x38, 0x29, 0x1e, 0x04, 0xed, 0x84,
+	0x86, 0x57, 0x41, 0x07, 0xf3, 0xa0, 0x4b, 0x58, 0xc0, 0x49, 0x16, 0x11, 0xe6, 0x80, 0xad, 0xd2,
+	0x76, 0xc5, 0xff, 0xde, 0x28, 0x77, 0x5d, 0x8d, 0xf1, 0x6d, 0x9e, 0x1e, 0x6a, 0xa5, 0x78, 0xe0,
+	0x4b, 0xcb, 0x0b, 0xcc, 0xcf, 0x08, 0x3b, 0x57, 0x6a, 0x88, 0xc1, 0xc6, 0xe2, 0x94, 0x90, 0x66,
+	0x82, 0xe1, 0x50, 0x38, 0x35, 0x05, 0xff, 0xfd, 0x51, 0xee, 0x7e, 0xf0, 0x6d, 0xf0, 0x85, 0xaf,
+	0x87, 0xd6, 0x67, 0x09, 0x0e, 0x0b, 0xc3, 0x5f, 0x4b, 0xc0, 0x2a, 0xb6, 0x0c, 0x5b, 0x60, 0x69,
+	0xaa, 0x74, 0x90, 0x16, 0xe0, 0x6f, 0x41, 0x33, 0xa4, 0x59, 0x9f, 0x30, 0x1e, 0xd3, 0x2c, 0x60,
+	0x45, 0x8d, 0x54, 0xfd, 0xcf, 0x65, 0x84, 0xfe, 0x9d, 0xbb, 0x3f, 0xe8, 0xc4, 0xe2, 0xb2, 0xd7,
+	0xde, 0x09, 0x69, 0xba, 0x1b, 0x52, 0x9e, 0x52, 0x6e, 0x1e, 0x1f, 0xf1, 0xe8, 0x6a, 0x57, 0x0c,
+	0xbb, 0x84, 0xef, 0x1c, 0x91, 0x70, 0x94, 0xbb, 0xeb, 0x26, 0xe9, 0x66, 0xe1, 0x3c, 0xd4, 0x98,
+	0x68, 0x90, 0x54, 0xfc, 0xed, 0x01, 0xa8, 0x4d, 0x25, 0x2d, 0x4c, 0x41, 0xf3, 0x92, 0xa6, 0x84,
+	0x0b, 0x82, 0x23, 0xbd, 0x45, 0x53, 0xdd, 0x47, 0xef, 0x49, 0x7f, 0x92, 0x89, 0x09, 0xfd, 0x1c,
+	0x94, 0x87, 0x1a, 0x63, 0x8d, 0x0a, 0x11, 0x1c, 0x82, 0x46, 0x84, 0x69, 0xf0, 0x9a, 0xb2, 0x2b,
+	0xc3, 0xa6, 0x37, 0x7c, 0xfe, 0xfe, 0x6c, 0xd7, 0xb9, 0x5b, 0x3f, 0x3a, 0xf8, 0xc5, 0x73, 0xca,
+	0xae, 0x14, 0xe6, 0x28, 0x77, 0x1f, 0x6a, 0xf6, 0x59, 0x64, 0x0f, 0xd5, 0x23, 0x4c, 0xc7, 0x6e,
+	0xf0, 0x97, 0xc0, 0x1e, 0x3b, 0xf0, 0x5e, 0xb7, 0x4b, 0x99, 0x30, 0x2f, 0x95, 0x8f, 0xae, 0x73,
+	0xb7, 0x61, 0x20, 0xcf, 0xb5, 0x65, 0x94, 0xbb, 0x8f, 0xe6, 0x40, 0xcd, 0x1c, 0x0f, 0x35, 0x0c,
+	0xac, 0x71, 0x85, 0x1c, 0xd4, 0x49, 0xdc, 0x7d, 0xba, 0xff, 0xb1, 0xd9, 0x51, 0x45, 0xed, 0xe8,
+	0xec, 0x56, 0x3b, 0xaa, 0x1d, 0x9f, 0x9c, 0x3d, 0xdd, 0xff, 0xb8, 0xd8, 0x90, 0x79, 0x85, 0x4c,
+	0xc3, 0x7a, 0xa8, 0xa6, 0x45, 0xbd, 0x9b, 0x13, 0x60, 0x44, 0x55, 0x98, 0xea, 0x05, 0x55, 0xf5,
+	0xb7, 0xaf, 0x73, 0x17, 0x68, 0x24, 0x59, 0x9a, 0x93, 0x73, 0x69, 0x0f, 0x7f, 0x87, 0x33, 0x11,
+	0xf7, 0xd2, 0x02, 0x0b, 0xe8, 0xc9, 0xd2, 0x6b, 0xbc, 0xfe, 0x7d, 0xb3, 0xfe, 0xe5, 0x3b, 0xaf,
+	0x7f, 0xff, 0x5d, 0xeb, 0xdf, 0x9f, 0x5d, 0xbf, 0xf6, 0x19, 0x93, 0x3e, 0x33, 0xa4, 0x2b, 0x77,
+	0x26, 0x7d, 0xf6, 0x2e, 0xd2, 0x67, 0xb3, 0xa4, 0xda, 0x47, 0x26, 0xfb, 0x5c, 0x24, 0x1c, 0xeb,
+	0xee, 0xc9, 0xbe, 0x10, 0xd4, 0xc6, 0x58, 0xa3, 0xe9, 0xfe, 0x00, 0x5a, 0x21, 0xcd, 0xb8, 0x90,
+	0xba, 0x8c, 0x76, 0x13, 0x62, 0x38, 0xab, 0x8a, 0xf3, 0xe4, 0x56, 0x9c, 0x4f, 0xc6, 0xf5, 0xbd,
+	0x80, 0xe7, 0xa1, 0xb5, 0x59, 0xb5, 0x66, 0xef, 0x02, 0xbb, 0x4b, 0x04, 0x61, 0xbc, 0xdd, 0x63,
+	0x1d, 0xc3, 0x0c, 0x14, 0xf3, 0xf1, 0xad, 0x98, 0x4d, 0x1d, 0xcc, 0x63, 0x79, 0xa8, 0x39, 0x51,
+	0x69, 0xc6, 0x2f, 0x41, 0x23, 0x96, 0xcb, 0x68, 0xf7, 0x12, 0xc3, 0x57, 0x53, 0x7c, 0x87, 0xb7,
+	0xe2, 0x33, 0xc5, 0x3c, 0x8b, 0xe4, 0xa1, 0xd5, 0x42, 0xa1, 0xb9, 0x7a, 0x00, 0xa6, 0xbd, 0x98,
+	0x05, 0x9d, 0x04, 0x87, 0x31, 0x61, 0x86, 0xaf, 0xae, 0xf8, 0x5e, 0xdc, 0x8a, 0xef, 0xb1, 0x79,
+	0xc5, 0x2f, 0xa0, 0x79, 0xc8, 0x96, 0xca, 0x17, 0x5a, 0xa7, 0x69, 0x23, 0x50, 0x6f, 0x13, 0x96,
+	0xc4, 0x99, 0x21, 0x5c, 0x55, 0x84, 0x07, 0xb7, 0x22, 0x34, 0x79, 0x3a, 0x8d, 0xe3, 0xa1, 0x9a,
+	0x16, 0xc7, 0x2c, 0x09, 0xcd, 0x22, 0x5a, 0xb0, 0x3c, 0xb8, 0x3b, 0xcb, 0x34, 0x8e, 0x87, 0x6a,
+	0x5a, 0xd4, 0x2c, 0x03, 0xb0, 0x86, 0x19, 0xa3, 0x6f, 0xe6, 0x62, 0x08, 0xf5, 0x0d, 0x74, 0x2b,
+	0xb2, 0xa2, 0x61, 0x58, 0x84, 0x93, 0x0d, 0x83, 0xd4, 0xce, 0x44, 0xb1, 0x07, 0x60, 0x87, 0xe1,
+	0xe1, 0x1c, 0x71, 0xeb, 0xee, 0x87, 0xb7, 0x88, 0xe6, 0x21, 0x5b, 0x2a, 0x67, 0x68, 0x7f, 0x0f,
+	0x5a, 0x29, 0x61, 0x1d, 0x12, 0x64, 0x44, 0xf0, 0x6e, 0x12, 0x0b, 0x43, 0xfc, 0xf0, 0xee, 0xf5,
+	0xf8, 0x2e, 0x3c, 0x0f, 0x41, 0xa5, 0x7e, 0x65, 0xb4, 0xe3, 0xe2, 0xe0, 0x97, 0x38, 0xeb, 0x5c,
+	0xe2, 0xd8, 0xd0, 0xae, 0xdf, 0xbd, 0x38, 0x66, 0x91, 0x3c, 0xb4, 0x5a, 0x28, 0xc6, 0xf9, 0x13,
+	0xe2, 0x2c, 0xec, 0x15, 0xf9, 0xf3, 0xe8, 0xee, 0xf9, 0x33, 0x8d, 0x23, 0xbb, 0x58, 0x25, 0x2a,
+	0x96, 0xd3, 0x8a, 0xd5, 0xb0, 0x9b, 0xa7, 0x15, 0xab, 0x69, 0xdb, 0xa7, 0x15, 0xcb, 0xb6, 0x1f,
+	0x9c, 0x56, 0xac, 0x35, 0xbb, 0x85, 0x56, 0x87, 0x34, 0xa1, 0x41, 0xff, 0x13, 0x3d, 0x09, 0xd5,
+	0xc8, 0x1b, 0xcc, 0xcd, 0x3b, 0x12, 0x35, 0x42, 0x2c, 0x70, 0x32, 0xe4, 0x26, 0x54, 0xc8, 0xd6,
+	0x01, 0x9c, 0xba, 0xb5, 0x77, 0xc1, 0xd2, 0xb9, 0x90, 0xfd, 0xbf, 0x0d, 0xca, 0x57, 0x64, 0x68,
+	0x1a, 0x26, 0x39, 0x94, 0x4d, 0x54, 0x1f, 0x27, 0x3d, 0xd3, 0x24, 0x21, 0x2d, 0x78, 0x7f, 0x2c,
+	0x81, 0xc6, 0xf9, 0x90, 0x0b, 0x92, 0x16, 0xad, 0x17, 0x74, 0xc0, 0x0a, 0x8e, 0x22, 0x46, 0x38,
+	0x37, 0xd3, 0x0b, 0x11, 0x42, 0x50, 0x91, 0xdd, 0xac, 0x41, 0x50, 0x63, 0xe8, 0x83, 0x15, 0x2e,
+	0x28, 0xc3, 0x1d, 0xe2, 0x94, 0x55, 0xef, 0xfa, 0x68, 0xb1, 0x77, 0x55, 0x4b, 0xf2, 0x9b, 0xb2,
+	0x2d, 0xfb, 0xfb, 0x7f, 0xdc, 0x95, 0x73, 0xed, 0x8f, 0x8a, 0x89, 0xde, 0x19, 0x68, 0x5e, 0x30,
+	0x9c, 0x71, 0x1c, 0x8a, 0x98, 0x66, 0x2f, 0x69, 0x47, 0x51, 0xa9, 0xab, 0x59, 0xaf, 0x40, 0x8d,
+	0xe1, 0x0f, 0x41, 0x25, 0xa1, 0x1d, 0xee, 0xdc, 0x57, 0x3c, 0x0f, 0x17, 0x79, 0x5e, 0xd2, 0x0e,
+	0x52, 0x2e, 0xde, 0x3f, 0xef, 0x83, 0xf2, 0x4b, 0xda, 0xf9, 0x8e, 0xbd, 0xac, 0x83, 0x65, 0x41,
+	0xbb, 0x71, 0xa8, 0xe1, 0xaa, 0xc8, 0x48, 0x92, 0x38, 0xc2, 0x02, 0xab, 0xe6, 0xa6, 0x8e, 0xd4,
+	0x18, 0xee, 0x81, 0xba, 0xee, 0x5f, 0xb3, 0x5e, 0xda, 0x26, 0x4c, 0xf5, 0x28, 0x15, 0xbf, 0x79,
+	0x93, 0xbb, 0x35, 0xa5, 0x7f, 0xa5, 0xd4, 0x68, 0x5a, 0x80, 0x1f, 0x82, 0x15, 0x31, 0x98, 0x6e,
+	0x2f, 0xd6, 0x6e, 0x72, 0xb7, 0x29, 0x26, 0xdb, 0x94, 0xdd, 0x03, 0x5a, 0x16, 0x03, 0xf9, 0x84,
+	0xbb, 0xc0, 0x12, 0x83, 0x20, 0xce, 0x22, 0x32, 0x50, 0x1d, 0x44, 0xc5, 0x6f, 0xdd, 0xe4, 0xae,
+	0x3d, 0xe5, 0x7e, 0x22, 0x6d, 0x68, 0x45, 0x0c, 0xd4, 0x00, 0x7e, 0x08, 0x80, 0x5e, 0x92, 0x62,
+	0xd0, 0xf7, 0xff, 0xea, 0x4d, 0xee, 0x56, 0x95, 0x56, 0x61, 0x4f, 0x86, 0xd0, 0x03, 0x4b, 0x1a,
+	0xdb, 0x52, 0xd8, 0xf5, 0x9b, 0xdc, 0xb5, 0x12, 0xda, 0xd1, 0x98, 0xda, 0x24, 0x43, 0xc5, 0x48,
+	0x4a, 0xfb, 0x24, 0x52, 0x57, 0xac, 0x85, 0x0a, 0xd1, 0xfb, 0xf3, 0x7d, 0x60, 0x5d, 0x0c, 0x10,
+	0xe1, 0xbd, 0x44, 0xc0, 0xe7, 0xc0, 0x2e, 0xba, 0xf7, 0x60, 0x26, 0xb4, 0xfe, 0x93, 0xc9, 0x75,
+	0x37, 0xef, 0xe1, 0xa1, 0x66, 0xa1, 0x3a, 0x30, 0xf1, 0x6f, 0x81, 0xa5, 0x76, 0x42, 0x69, 0xaa,
+	0x92, 0xa9, 0x8e, 0xb4, 0x00, 0x91, 0x8a, 0x9a, 0x3a, 0xe5, 0xb2, 0xfa, 0x6a, 0xfc, 0x60, 0xf1,
+	0x94, 0xe7, 0x52, 0xc5, 0x5f, 0x37, 0x1f, 0x44, 0x0d, 0xcd, 0x6d, 0xe6, 0x7b, 0x32, 0xb6, 0x2a,
+	0x95, 0x6c, 0x50, 0x66, 0x44, 0xa8, 0x43, 0xab, 0x23, 0x39, 0x84, 0x1b, 0xc0, 0x62, 0xa4, 0x4f,
+	0x98, 0x20, 0x91, 0x3a, 0x1c, 0x0b, 0x8d, 0x65, 0xf8, 0x18, 0x58, 0xf2, 0x2b, 0xa5, 0xc7, 0x49,
+	0xa4, 0x4f, 0x02, 0xad, 0x74, 0x30, 0xff, 0x82, 0x93, 0xe8, 0xd3, 0xca, 0x9f, 0xbe, 0x72, 0xef,
+	0x79, 0x18, 0xd4, 0x0e, 0xc2, 0x90, 0x70, 0x7e, 0xd1, 0xeb, 0x26, 0xe4, 0x3b, 0x32, 0x6c, 0x0f,
+	0xd4, 0x4d, 0x82, 0x07, 0x57, 0x64, 0x68, 0xf2, 0x4c, 0x67, 0x8d, 0xd1, 0xff, 0x8c, 0x0c, 0x39,
+	0x9a, 0x16, 0x0c, 0xc5, 0x57, 0x15, 0x50, 0xbb, 0x60, 0x38, 0x24, 0xe6, 0x33, 0x43, 0xe6, 0xaa,
+	0x14, 0x99, 0xa1, 0x30, 0x92, 0xe4, 0x16, 0x71, 0x4a, 0x68, 0x4f, 0x98, 0x92, 0x2c, 0x44, 0x39,
+	0x83, 0x11, 0x32, 0x20, 0xa1, 0x0a, 0x63, 0x05, 0x19, 0x09, 0xee, 0x83, 0xd5, 0x28, 0xe6, 0xea,
+	0xd3, 0x9f, 0x0b, 0x1c, 0x5e, 0xe9, 0xed, 0xfb, 0xf6, 0x4d, 0xee, 0xd6, 0x8d, 0xe1, 0x5c, 0xea,
+	0xd1, 0x8c, 0x04, 0x3f, 0x03, 0xcd, 0xc9, 0x34, 0x5d, 0xec, 0xea, 0x63, 0xdb, 0x87, 0x37, 0xb9,
+	0xdb, 0x18, 0xbb, 0xea, 0xb2, 0x9e, 0x93, 0xf5, 0xd7, 0x5b, 0xbb, 0xd7, 0x51, 0xc9, 0x67, 0x21,
+	0x2d, 0x48, 0x6d, 0x12, 0xa7, 0xb1, 0x50, 0xc9, 0xb6, 0x84, 0xb4, 0x00, 0x3f, 0x03, 0x55, 0xda,
+	0x27, 0x8c, 0xc5, 0x11, 0xe1, 0x0e, 0x78, 0x8f, 0xff, 0x06, 0x68, 0xe2, 0x2f, 0x37, 0x67, 0x7e,
+	0x6b, 0xa4, 0x24, 0xa5, 0x6c, 0xe8, 0xd4, 0x26, 0x9b, 0xd3, 0x86, 0x9f, 0x2b, 0x3d, 0x9a, 0x91,
+	0xa0, 0x0f, 0xa0, 0x99, 0xc6, 0x88, 0xe8, 0xb1, 0x2c, 0x50, 0xf5, 0x5f, 0x57, 0x73, 0x55, 0x15,
+	0x6a, 0x2b, 0x52, 0xc6, 0x23, 0x2c, 0x30, 0x5a, 0xd0, 0xc0, 0x9f, 0x00, 0xa8, 0xcf, 0x24, 0xf8,
+	0x92, 0xd3, 0xf1, 0x8f, 0x0f, 0xdd, 0xdf, 0x28, 0x7e, 0x6d, 0x35, 0x6b, 0xb6, 0xb5, 0x74, 0xca,
+	0xa9, 0xd9, 0xc5, 0x69, 0xc5, 0xaa, 0xd8, 0x4b, 0xa7, 0x15, 0x6b, 0xc5, 0xb6, 0xc6, 0xf1, 0x33,
+	0xbb, 0x40, 0x6b, 0x85, 0x3c, 0xb5, 0x3c, 0xff, 0xa7, 0x5f, 0x5f, 0x6f, 0x96, 0xbe, 0xb9, 0xde,
+	0x2c, 0xfd, 0xf7, 0x7a, 0xb3, 0xf4, 0x97, 0xb7, 0x9b, 0xf7, 0xbe, 0x79, 0xbb, 0x79, 0xef, 0x5f,
+	0x6f, 0x37, 0xef, 0xfd, 0x6a, 0xfa, 0x92, 0x22, 0x7d, 0x79, 0x47, 0x4d, 0xfe, 0x65, 0x0d, 0xa4,
+	0x46, 0x5f, 0x54, 0xed, 0x65, 0xf5, 0x97, 0xea, 0x93, 0xff, 0x0d, 0x00, 0x1d, 0x22, 0xcd, 0x3d,
+	0xeb, 0x12, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -813,6 +998,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxBlockGasPerContract != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxBlockGasPerContract))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxBlockGasPerSender != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.MaxBlockGasPerSender))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AllowedCodeHashes) > 0 {
+		for iNdEx := len(m.AllowedCodeHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedCodeHashes[iNdEx])
+			copy(dAtA[i:], m.AllowedCodeHashes[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedCodeHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AllowedDeployers) > 0 {
+		for iNdEx := len(m.AllowedDeployers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDeployers[iNdEx])
+			copy(dAtA[i:], m.AllowedDeployers[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.AllowedDeployers[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.AllowUnprotectedTxs {
 		i--
 		if m.AllowUnprotectedTxs {
@@ -882,6 +1109,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1175,6 +1442,57 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SystemContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SystemContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SystemContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TransactionLogs) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1567,6 +1885,45 @@ func (m *Params) Size() (n int) {
 	if m.AllowUnprotectedTxs {
 		n += 2
 	}
+	if len(m.AllowedDeployers) > 0 {
+		for _, s := range m.AllowedDeployers {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.AllowedCodeHashes) > 0 {
+		for _, s := range m.AllowedCodeHashes {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if m.MaxBlockGasPerSender != 0 {
+		n += 1 + sovEvm(uint64(m.MaxBlockGasPerSender))
+	}
+	if m.MaxBlockGasPerContract != 0 {
+		n += 1 + sovEvm(uint64(m.MaxBlockGasPerContract))
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovEvm(uint64(l))
 	return n
 }
 
@@ -1671,6 +2028,29 @@ func (m *State) Size() (n int) {
 	return n
 }
 
+func (m *SystemContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *TransactionLogs) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2063,22 +2443,274 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowUnprotectedTxs = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvm(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvm
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDeployers", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDeployers = append(m.AllowedDeployers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedCodeHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedCodeHashes = append(m.AllowedCodeHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockGasPerSender", wireType)
+			}
+			m.MaxBlockGasPerSender = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockGasPerSender |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockGasPerContract", wireType)
+			}
+			m.MaxBlockGasPerContract = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockGasPerContract |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
@@ -2912,6 +3544,154 @@ func (m *State) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SystemContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SystemContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SystemContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Storage = append(m.Storage, State{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *TransactionLogs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -16,8 +16,12 @@
 package types
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	ethermint "github.com/evmos/ethermint/types"
 )
 
@@ -29,6 +33,20 @@ func (ga GenesisAccount) Validate() error {
 	return ga.Storage.Validate()
 }
 
+// Validate performs a basic validation of a SystemContract fields.
+func (sc SystemContract) Validate() error {
+	if err := ethermint.ValidateNonZeroAddress(sc.Address); err != nil {
+		return err
+	}
+	if len(sc.Code) == 0 {
+		return errors.New("empty code")
+	}
+	if _, err := hex.DecodeString(sc.Code); err != nil {
+		return fmt.Errorf("invalid code: %w", err)
+	}
+	return sc.Storage.Validate()
+}
+
 // DefaultGenesisState sets default evm genesis state with empty accounts and default params and
 // chain config values.
 func DefaultGenesisState() *GenesisState {
@@ -60,5 +78,27 @@ func (gs GenesisState) Validate() error {
 		seenAccounts[acc.Address] = true
 	}
 
+	for _, contract := range gs.SystemContracts {
+		if seenAccounts[contract.Address] {
+			return fmt.Errorf("duplicated genesis account or system contract %s", contract.Address)
+		}
+		if err := contract.Validate(); err != nil {
+			return fmt.Errorf("invalid system contract %s: %w", contract.Address, err)
+		}
+		seenAccounts[contract.Address] = true
+	}
+
+	seenBlocked := make(map[common.Address]bool)
+	for _, address := range gs.BlockedAddresses {
+		if err := ethermint.ValidateNonZeroAddress(address); err != nil {
+			return fmt.Errorf("invalid blocked address %s: %w", address, err)
+		}
+		addr := common.HexToAddress(address)
+		if seenBlocked[addr] {
+			return fmt.Errorf("duplicated blocked address %s", address)
+		}
+		seenBlocked[addr] = true
+	}
+
 	return gs.Params.Validate()
 }
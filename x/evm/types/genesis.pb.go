@@ -29,6 +29,11 @@ type GenesisState struct {
 	Accounts []GenesisAccount `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// system_contracts is an array containing the contracts predeployed at genesis.
+	SystemContracts []SystemContract `protobuf:"bytes,3,rep,name=system_contracts,json=systemContracts,proto3" json:"system_contracts"`
+	// blocked_addresses defines the hex addresses which are not allowed to send or
+	// receive EVM value.
+	BlockedAddresses []string `protobuf:"bytes,4,rep,name=blocked_addresses,json=blockedAddresses,proto3" json:"blocked_addresses,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +83,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetSystemContracts() []SystemContract {
+	if m != nil {
+		return m.SystemContracts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockedAddresses() []string {
+	if m != nil {
+		return m.BlockedAddresses
+	}
+	return nil
+}
+
 // GenesisAccount defines an account to be initialized in the genesis state.
 // Its main difference between with Geth's GenesisAccount is that it uses a
 // custom storage type and that it doesn't contain the private key field.
@@ -152,26 +171,30 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/genesis.proto", fileDescriptor_9bcdec50cc9d156d) }
 
 var fileDescriptor_9bcdec50cc9d156d = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xbf, 0x4e, 0x83, 0x40,
-	0x1c, 0xe6, 0x6c, 0x53, 0xec, 0xd5, 0xa8, 0xb9, 0x98, 0x48, 0x18, 0xae, 0xa4, 0x83, 0x61, 0x3a,
-	0xd2, 0x9a, 0x38, 0x2b, 0x8b, 0xab, 0xa1, 0x9b, 0xdb, 0x15, 0x7e, 0xa1, 0x0c, 0x70, 0x84, 0xbb,
-	0x12, 0x5d, 0x1d, 0x9d, 0x7c, 0x0e, 0x9f, 0xa4, 0x63, 0x47, 0x27, 0x35, 0xf0, 0x22, 0x86, 0x83,
-	0xd6, 0x28, 0xdb, 0x07, 0xdf, 0xbf, 0xdf, 0x7d, 0x98, 0x82, 0x5a, 0x43, 0x91, 0x26, 0x99, 0xf2,
-	0xa0, 0x4c, 0xbd, 0x72, 0xee, 0xc5, 0x90, 0x81, 0x4c, 0x24, 0xcb, 0x0b, 0xa1, 0x04, 0x39, 0x3f,
-	0xf0, 0x0c, 0xca, 0x94, 0x95, 0x73, 0xdb, 0xee, 0x39, 0x1a, 0x42, 0xab, 0xed, 0x8b, 0x58, 0xc4,
-	0x42, 0x43, 0xaf, 0x41, 0xed, 0xdf, 0xd9, 0x2b, 0xc2, 0x27, 0xf7, 0x6d, 0xea, 0x52, 0x71, 0x05,
-	0xc4, 0xc7, 0xc7, 0x3c, 0x0c, 0xc5, 0x26, 0x53, 0xd2, 0x42, 0xce, 0xc0, 0x9d, 0x2c, 0x1c, 0xf6,
-	0xbf, 0x87, 0x75, 0x8e, 0xbb, 0x56, 0xe8, 0x0f, 0xb7, 0x9f, 0x53, 0x23, 0x38, 0xf8, 0xc8, 0x0d,
-	0x1e, 0xe5, 0xbc, 0xe0, 0xa9, 0xb4, 0x8e, 0x1c, 0xe4, 0x4e, 0x16, 0x56, 0x3f, 0xe1, 0x41, 0xf3,
-	0x9d, 0xb3, 0x53, 0xcf, 0x5e, 0x10, 0x3e, 0xfd, 0x1b, 0x4d, 0x2c, 0x6c, 0xf2, 0x28, 0x2a, 0x40,
-	0x36, 0xd7, 0x20, 0x77, 0x1c, 0xec, 0x3f, 0x09, 0xc1, 0xc3, 0x50, 0x44, 0xa0, 0x2b, 0xc6, 0x81,
-	0xc6, 0xc4, 0xc7, 0xa6, 0x54, 0xa2, 0xe0, 0x31, 0x58, 0x03, 0x7d, 0xfb, 0x65, 0xbf, 0x59, 0x3f,
-	0xd3, 0x3f, 0x6b, 0x8a, 0xdf, 0xbf, 0xa6, 0xe6, 0xb2, 0xd5, 0x07, 0x7b, 0xa3, 0x7f, 0xbb, 0xad,
-	0x28, 0xda, 0x55, 0x14, 0x7d, 0x57, 0x14, 0xbd, 0xd5, 0xd4, 0xd8, 0xd5, 0xd4, 0xf8, 0xa8, 0xa9,
-	0xf1, 0x78, 0x15, 0x27, 0x6a, 0xbd, 0x59, 0xb1, 0x50, 0xa4, 0xcd, 0xae, 0x42, 0x7a, 0xbf, 0x73,
-	0x3f, 0xe9, 0xc1, 0xd5, 0x73, 0x0e, 0x72, 0x35, 0xd2, 0xd3, 0x5e, 0xff, 0x04, 0x00, 0x00, 0xff,
-	0xff, 0xe3, 0x61, 0xf0, 0x9f, 0xc0, 0x01, 0x00, 0x00,
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x51, 0xcd, 0x4e, 0xea, 0x40,
+	0x14, 0xee, 0x00, 0x81, 0xcb, 0x70, 0x73, 0xe1, 0x4e, 0x4c, 0x6c, 0x58, 0x94, 0x86, 0x85, 0x69,
+	0x62, 0xd2, 0x06, 0x4c, 0x5c, 0x4b, 0x5d, 0xb8, 0xd5, 0xb2, 0x73, 0x43, 0x4a, 0x7b, 0x52, 0x88,
+	0xb6, 0x43, 0x7a, 0x86, 0x46, 0xb6, 0x2e, 0x5d, 0xf9, 0x1c, 0x3e, 0x09, 0x4b, 0x96, 0xae, 0xd4,
+	0xc0, 0x8b, 0x98, 0x4e, 0x07, 0x0c, 0xa2, 0xbb, 0xd3, 0xf3, 0xfd, 0xf4, 0x3b, 0xf3, 0x51, 0x03,
+	0xc4, 0x04, 0xd2, 0x78, 0x9a, 0x08, 0x07, 0xb2, 0xd8, 0xc9, 0x7a, 0x4e, 0x04, 0x09, 0xe0, 0x14,
+	0xed, 0x59, 0xca, 0x05, 0x67, 0xad, 0x1d, 0x6e, 0x43, 0x16, 0xdb, 0x59, 0xaf, 0xdd, 0x3e, 0x50,
+	0xe4, 0x80, 0x64, 0xb7, 0x8f, 0x22, 0x1e, 0x71, 0x39, 0x3a, 0xf9, 0x54, 0x6c, 0xbb, 0x4f, 0x25,
+	0xfa, 0xf7, 0xaa, 0x70, 0x1d, 0x0a, 0x5f, 0x00, 0x73, 0xe9, 0x1f, 0x3f, 0x08, 0xf8, 0x3c, 0x11,
+	0xa8, 0x13, 0xb3, 0x6c, 0x35, 0xfa, 0xa6, 0xfd, 0xfd, 0x3f, 0xb6, 0x52, 0x0c, 0x0a, 0xa2, 0x5b,
+	0x59, 0xbe, 0x75, 0x34, 0x6f, 0xa7, 0x63, 0xe7, 0xb4, 0x3a, 0xf3, 0x53, 0x3f, 0x46, 0xbd, 0x64,
+	0x12, 0xab, 0xd1, 0xd7, 0x0f, 0x1d, 0xae, 0x25, 0xae, 0x94, 0x8a, 0xcd, 0x6e, 0x68, 0x0b, 0x17,
+	0x28, 0x20, 0x1e, 0x05, 0x3c, 0x11, 0xa9, 0x1f, 0x08, 0xd4, 0xcb, 0xbf, 0x65, 0x18, 0x4a, 0xe6,
+	0xa5, 0x22, 0x2a, 0xa7, 0x26, 0xee, 0x6d, 0x91, 0x9d, 0xd2, 0xff, 0xe3, 0x7b, 0x1e, 0xdc, 0x41,
+	0x38, 0xf2, 0xc3, 0x30, 0x05, 0x44, 0x40, 0xbd, 0x62, 0x96, 0xad, 0xba, 0xd7, 0x52, 0xc0, 0x60,
+	0xbb, 0xef, 0x3e, 0x12, 0xfa, 0x6f, 0xff, 0x34, 0xa6, 0xd3, 0x9a, 0xd2, 0xe9, 0xc4, 0x24, 0x56,
+	0xdd, 0xdb, 0x7e, 0x32, 0x46, 0x2b, 0x01, 0x0f, 0x41, 0x9e, 0x58, 0xf7, 0xe4, 0xcc, 0x5c, 0x5a,
+	0x43, 0xc1, 0x53, 0x3f, 0x02, 0x95, 0xfb, 0xf8, 0x87, 0xdc, 0xf9, 0x33, 0xbb, 0xcd, 0x3c, 0xee,
+	0xcb, 0x7b, 0xa7, 0x36, 0x2c, 0xf8, 0xde, 0x56, 0xe8, 0x5e, 0x2c, 0xd7, 0x06, 0x59, 0xad, 0x0d,
+	0xf2, 0xb1, 0x36, 0xc8, 0xf3, 0xc6, 0xd0, 0x56, 0x1b, 0x43, 0x7b, 0xdd, 0x18, 0xda, 0xed, 0x49,
+	0x34, 0x15, 0x93, 0xf9, 0xd8, 0x0e, 0x78, 0x9c, 0xf7, 0xca, 0xd1, 0xf9, 0xaa, 0xfb, 0x41, 0x16,
+	0x2e, 0x16, 0x33, 0xc0, 0x71, 0x55, 0x56, 0x7b, 0xf6, 0x39, 0x00, 0x09, 0xa6, 0xcb, 0xde, 0x40,
+	0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -194,6 +217,29 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockedAddresses) > 0 {
+		for iNdEx := len(m.BlockedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedAddresses[iNdEx])
+			copy(dAtA[i:], m.BlockedAddresses[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.BlockedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SystemContracts) > 0 {
+		for iNdEx := len(m.SystemContracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SystemContracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -297,6 +343,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SystemContracts) > 0 {
+		for _, e := range m.SystemContracts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BlockedAddresses) > 0 {
+		for _, s := range m.BlockedAddresses {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -425,6 +483,72 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemContracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SystemContracts = append(m.SystemContracts, SystemContract{})
+			if err := m.SystemContracts[len(m.SystemContracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedAddresses = append(m.BlockedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -204,6 +204,74 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid system contract",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				SystemContracts: []SystemContract{
+					{
+						Address: suite.address,
+						Code:    suite.code,
+					},
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "system contract with empty code",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				SystemContracts: []SystemContract{
+					{
+						Address: suite.address,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "system contract duplicated with genesis account",
+			genState: &GenesisState{
+				Params: DefaultParams(),
+				Accounts: []GenesisAccount{
+					{
+						Address: suite.address,
+						Code:    suite.code,
+					},
+				},
+				SystemContracts: []SystemContract{
+					{
+						Address: suite.address,
+						Code:    suite.code,
+					},
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "valid blocked address",
+			genState: &GenesisState{
+				Params:           DefaultParams(),
+				BlockedAddresses: []string{suite.address},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid blocked address",
+			genState: &GenesisState{
+				Params:           DefaultParams(),
+				BlockedAddresses: []string{"0x1"},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicated blocked address",
+			genState: &GenesisState{
+				Params:           DefaultParams(),
+				BlockedAddresses: []string{suite.address, suite.address},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid params",
 			genState: &GenesisState{
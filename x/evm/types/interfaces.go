@@ -62,6 +62,8 @@ type FeeMarketKeeper interface {
 	GetBaseFee(ctx sdk.Context) *big.Int
 	GetParams(ctx sdk.Context) feemarkettypes.Params
 	AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64, error)
+	AddTransientBaseFees(ctx sdk.Context, fees sdk.Coins)
+	AddTransientTip(ctx sdk.Context, tip *big.Int)
 }
 
 // Event Hooks
@@ -73,6 +75,24 @@ type EvmHooks interface {
 	PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error
 }
 
+// EvmTxHooks are optional evm hooks observing the execution of every tx, including the failed ones.
+type EvmTxHooks interface {
+	// Called before the tx is executed, if return an error, the tx is not executed and fails consuming
+	// all its gas with the ErrPreTxExecution VM error.
+	PreTxExecution(ctx sdk.Context, msg core.Message) error
+	// Called after the tx is executed with the result, including the VM error and the gas used.
+	// If the tx succeeded and it returns an error, the whole transaction is reverted. If the tx failed,
+	// it's called on a discarded cache context so the state can't be altered, and the error is ignored,
+	// a tx reverted by the hooks is observed again as failed with the ErrPostTxProcessing VM error.
+	PostTxExecution(ctx sdk.Context, msg core.Message, res *MsgEthereumTxResponse) error
+}
+
+// FeeDenomOracle provides the conversion rates of the alternative fee denoms without a static rate.
+type FeeDenomOracle interface {
+	// GetConversionRate returns the amount of denom units worth one unit of the EVM denom.
+	GetConversionRate(ctx sdk.Context, denom string) (sdk.Dec, error)
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
@@ -41,6 +41,7 @@ const (
 	prefixCode = iota + 1
 	prefixStorage
 	prefixParams
+	prefixBlockedAddress
 )
 
 // prefix bytes for the EVM transient store
@@ -49,6 +50,11 @@ const (
 	prefixTransientTxIndex
 	prefixTransientLogSize
 	prefixTransientGasUsed
+	prefixTransientTxFees
+	prefixTransientTxFeePayer
+	prefixTransientSenderGasUsed
+	prefixTransientContractGasUsed
+	prefixTransientTxGasQuotaExceeded
 )
 
 // KVStore key prefixes
@@ -56,14 +62,22 @@ var (
 	KeyPrefixCode    = []byte{prefixCode}
 	KeyPrefixStorage = []byte{prefixStorage}
 	KeyPrefixParams  = []byte{prefixParams}
+
+	KeyPrefixBlockedAddress = []byte{prefixBlockedAddress}
 )
 
 // Transient Store key prefixes
 var (
-	KeyPrefixTransientBloom   = []byte{prefixTransientBloom}
-	KeyPrefixTransientTxIndex = []byte{prefixTransientTxIndex}
-	KeyPrefixTransientLogSize = []byte{prefixTransientLogSize}
-	KeyPrefixTransientGasUsed = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientBloom      = []byte{prefixTransientBloom}
+	KeyPrefixTransientTxIndex    = []byte{prefixTransientTxIndex}
+	KeyPrefixTransientLogSize    = []byte{prefixTransientLogSize}
+	KeyPrefixTransientGasUsed    = []byte{prefixTransientGasUsed}
+	KeyPrefixTransientTxFees     = []byte{prefixTransientTxFees}
+	KeyPrefixTransientTxFeePayer = []byte{prefixTransientTxFeePayer}
+
+	KeyPrefixTransientSenderGasUsed      = []byte{prefixTransientSenderGasUsed}
+	KeyPrefixTransientContractGasUsed    = []byte{prefixTransientContractGasUsed}
+	KeyPrefixTransientTxGasQuotaExceeded = []byte{prefixTransientTxGasQuotaExceeded}
 )
 
 // AddressStoragePrefix returns a prefix to iterate over a given account storage.
@@ -75,3 +89,38 @@ func AddressStoragePrefix(address common.Address) []byte {
 func StateKey(address common.Address, key []byte) []byte {
 	return append(AddressStoragePrefix(address), key...)
 }
+
+// BlockedAddressKey defines the key under which a blocked address is stored.
+func BlockedAddressKey(address common.Address) []byte {
+	return append(KeyPrefixBlockedAddress, address.Bytes()...)
+}
+
+// TxFeesTransientKey defines the key under which the fees paid in an alternative fee denom by an
+// Ethereum transaction are stored.
+func TxFeesTransientKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTransientTxFees, txHash.Bytes()...)
+}
+
+// TxFeePayerTransientKey defines the key under which the account paying the fees of an Ethereum
+// transaction in place of the sender is stored.
+func TxFeePayerTransientKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTransientTxFeePayer, txHash.Bytes()...)
+}
+
+// SenderGasUsedTransientKey defines the key under which the gas consumed in the current block by the
+// transactions of a sender is stored.
+func SenderGasUsedTransientKey(sender common.Address) []byte {
+	return append(KeyPrefixTransientSenderGasUsed, sender.Bytes()...)
+}
+
+// ContractGasUsedTransientKey defines the key under which the gas consumed in the current block by the
+// transactions calling a contract is stored.
+func ContractGasUsedTransientKey(contract common.Address) []byte {
+	return append(KeyPrefixTransientContractGasUsed, contract.Bytes()...)
+}
+
+// TxGasQuotaExceededTransientKey defines the key under which the Ethereum transactions exceeding the block
+// gas quotas are marked.
+func TxGasQuotaExceededTransientKey(txHash common.Hash) []byte {
+	return append(KeyPrefixTransientTxGasQuotaExceeded, txHash.Bytes()...)
+}
@@ -44,6 +44,8 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgSetSystemContract{}
+	_ sdk.Msg    = &MsgUpdateBlockedAddresses{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -356,6 +358,12 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
 
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.Tx, error) {
+	return msg.BuildTxWithExpiry(b, evmDenom, nil)
+}
+
+// BuildTxWithExpiry builds the canonical cosmos tx from ethereum msg, including the expiry extension
+// option if not nil.
+func (msg *MsgEthereumTx) BuildTxWithExpiry(b client.TxBuilder, evmDenom string, expiry *ExtensionOptionsEthereumTxExpiry) (signing.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
 	if !ok {
 		return nil, errors.New("unsupported builder")
@@ -365,6 +373,18 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 	if err != nil {
 		return nil, err
 	}
+	options := []*codectypes.Any{option}
+
+	if expiry != nil {
+		if err := expiry.Validate(); err != nil {
+			return nil, err
+		}
+		expiryOption, err := codectypes.NewAnyWithValue(expiry)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, expiryOption)
+	}
 
 	txData, err := UnpackTxData(msg.Data)
 	if err != nil {
@@ -376,7 +396,7 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 		fees = append(fees, sdk.NewCoin(evmDenom, feeAmt))
 	}
 
-	builder.SetExtensionOptions(option)
+	builder.SetExtensionOptions(options...)
 
 	// A valid msg should have empty `From`
 	msg.From = ""
@@ -411,3 +431,61 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgSetSystemContract message.
+func (m MsgSetSystemContract) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgSetSystemContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Contract.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetSystemContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateBlockedAddresses message.
+func (m MsgUpdateBlockedAddresses) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateBlockedAddresses) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Block) == 0 && len(m.Unblock) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "no address to block or unblock")
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, address := range append(append([]string{}, m.Block...), m.Unblock...) {
+		if err := types.ValidateNonZeroAddress(address); err != nil {
+			return err
+		}
+		addr := common.HexToAddress(address)
+		if seen[addr] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicated address %s", address)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateBlockedAddresses) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
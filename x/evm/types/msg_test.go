@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/evmos/ethermint/crypto/ethsecp256k1"
 	"github.com/evmos/ethermint/tests"
 
@@ -110,6 +111,37 @@ func (suite *MsgsTestSuite) TestMsgEthereumTx_BuildTx() {
 	}
 }
 
+func (suite *MsgsTestSuite) TestMsgEthereumTx_BuildTxWithExpiry() {
+	testCases := []struct {
+		name       string
+		expiry     *types.ExtensionOptionsEthereumTxExpiry
+		expOptions int
+		expError   bool
+	}{
+		{"no expiry", nil, 1, false},
+		{"with expiry", &types.ExtensionOptionsEthereumTxExpiry{MaxBlockHeight: 10, MaxTimestamp: 1000}, 2, false},
+		{"empty expiry", &types.ExtensionOptionsEthereumTxExpiry{}, 0, true},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewTx(nil, 0, &suite.to, nil, 100000, big.NewInt(1), big.NewInt(1), big.NewInt(0), []byte("test"), nil)
+
+		tx, err := msg.BuildTxWithExpiry(suite.clientCtx.TxConfig.NewTxBuilder(), "aphoton", tc.expiry)
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+		suite.Require().NoError(err, tc.name)
+
+		options := tx.(authante.HasExtensionOptionsTx).GetExtensionOptions()
+		suite.Require().Len(options, tc.expOptions, tc.name)
+
+		expiry, err := types.GetTxExpiry(options)
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(tc.expiry, expiry, tc.name)
+	}
+}
+
 func (suite *MsgsTestSuite) TestMsgEthereumTx_ValidateBasic() {
 	hundredInt := big.NewInt(100)
 	zeroInt := big.NewInt(0)
@@ -797,3 +829,32 @@ func assertEqual(orig *ethtypes.Transaction, cpy *ethtypes.Transaction) error {
 	}
 	return nil
 }
+
+func (suite *MsgsTestSuite) TestMsgUpdateBlockedAddresses_ValidateBasic() {
+	authority := sdk.AccAddress(suite.from.Bytes()).String()
+
+	testCases := []struct {
+		msg        string
+		authority  string
+		block      []string
+		unblock    []string
+		expectPass bool
+	}{
+		{msg: "pass", authority: authority, block: []string{suite.from.Hex()}, unblock: []string{suite.to.Hex()}, expectPass: true},
+		{msg: "invalid authority", authority: "foobar", block: []string{suite.from.Hex()}},
+		{msg: "no addresses", authority: authority},
+		{msg: "invalid address", authority: authority, block: []string{"0x1"}},
+		{msg: "zero address", authority: authority, unblock: []string{common.Address{}.Hex()}},
+		{msg: "address blocked and unblocked", authority: authority, block: []string{suite.from.Hex()}, unblock: []string{suite.from.Hex()}},
+	}
+
+	for _, tc := range testCases {
+		msg := &types.MsgUpdateBlockedAddresses{Authority: tc.authority, Block: tc.block, Unblock: tc.unblock}
+		err := msg.ValidateBasic()
+		if tc.expectPass {
+			suite.Require().NoError(err, tc.msg)
+		} else {
+			suite.Require().Error(err, tc.msg)
+		}
+	}
+}
@@ -21,7 +21,10 @@ import (
 
 	"github.com/ethereum/go-ethereum/params"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/evmos/ethermint/types"
 )
@@ -91,6 +94,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateAllowedDeployers(p.AllowedDeployers); err != nil {
+		return err
+	}
+
+	if err := validateAllowedCodeHashes(p.AllowedCodeHashes); err != nil {
+		return err
+	}
+
+	if err := validateFeeDenoms(p.FeeDenoms); err != nil {
+		return err
+	}
+
+	if _, found := p.GetFeeDenom(p.EvmDenom); found {
+		return fmt.Errorf("evm denom %s cannot be an alternative fee denom", p.EvmDenom)
+	}
+
 	return validateChainConfig(p.ChainConfig)
 }
 
@@ -103,6 +122,60 @@ func (p Params) EIPs() []int {
 	return eips
 }
 
+// HasCreateAllowlist returns true if the contract deployments are restricted to the allowed
+// deployers or code hashes.
+func (p Params) HasCreateAllowlist() bool {
+	return len(p.AllowedDeployers) > 0 || len(p.AllowedCodeHashes) > 0
+}
+
+// IsDeployerAllowed returns true if the address is allowed to deploy contracts.
+func (p Params) IsDeployerAllowed(addr common.Address) bool {
+	if len(p.AllowedDeployers) == 0 {
+		return true
+	}
+	for _, deployer := range p.AllowedDeployers {
+		if common.HexToAddress(deployer) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// IsCodeHashAllowed returns true if the runtime code with the given hash is allowed to be deployed.
+func (p Params) IsCodeHashAllowed(codeHash common.Hash) bool {
+	if len(p.AllowedCodeHashes) == 0 {
+		return true
+	}
+	for _, hash := range p.AllowedCodeHashes {
+		if common.HexToHash(hash) == codeHash {
+			return true
+		}
+	}
+	return false
+}
+
+// GetFeeDenom returns the alternative fee denom with the given denomination.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+	return FeeDenom{}, false
+}
+
+// ValidateCreate returns an error if the deployment of the runtime code with the given hash by the
+// transaction origin is not allowed.
+func (p Params) ValidateCreate(origin common.Address, codeHash common.Hash) error {
+	if !p.IsDeployerAllowed(origin) {
+		return errorsmod.Wrapf(ErrCreateNotAllowed, "deployer %s is not allowed", origin)
+	}
+	if !p.IsCodeHashAllowed(codeHash) {
+		return errorsmod.Wrapf(ErrCreateNotAllowed, "code hash %s is not allowed", codeHash)
+	}
+	return nil
+}
+
 func validateEVMDenom(i interface{}) error {
 	denom, ok := i.(string)
 	if !ok {
@@ -135,6 +208,73 @@ func validateEIPs(i interface{}) error {
 	return nil
 }
 
+func validateAllowedDeployers(i interface{}) error {
+	deployers, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid allowed deployers type: %T", i)
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, deployer := range deployers {
+		if err := types.ValidateNonZeroAddress(deployer); err != nil {
+			return errorsmod.Wrap(err, "invalid allowed deployer")
+		}
+		addr := common.HexToAddress(deployer)
+		if seen[addr] {
+			return fmt.Errorf("duplicated allowed deployer %s", deployer)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
+
+func validateAllowedCodeHashes(i interface{}) error {
+	hashes, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid allowed code hashes type: %T", i)
+	}
+
+	seen := make(map[common.Hash]bool)
+	for _, hash := range hashes {
+		bz, err := hexutil.Decode(hash)
+		if err != nil || len(bz) != common.HashLength {
+			return fmt.Errorf("invalid allowed code hash %s", hash)
+		}
+		codeHash := common.BytesToHash(bz)
+		if seen[codeHash] {
+			return fmt.Errorf("duplicated allowed code hash %s", hash)
+		}
+		seen[codeHash] = true
+	}
+
+	return nil
+}
+
+func validateFeeDenoms(i interface{}) error {
+	feeDenoms, ok := i.([]FeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid fee denoms type: %T", i)
+	}
+
+	seen := make(map[string]bool)
+	for _, feeDenom := range feeDenoms {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return errorsmod.Wrapf(err, "invalid fee denom %s", feeDenom.Denom)
+		}
+		if seen[feeDenom.Denom] {
+			return fmt.Errorf("duplicated fee denom %s", feeDenom.Denom)
+		}
+		seen[feeDenom.Denom] = true
+
+		if !feeDenom.ConversionRate.IsNil() && feeDenom.ConversionRate.IsNegative() {
+			return fmt.Errorf("negative conversion rate for fee denom %s: %s", feeDenom.Denom, feeDenom.ConversionRate)
+		}
+	}
+
+	return nil
+}
+
 func validateChainConfig(i interface{}) error {
 	cfg, ok := i.(ChainConfig)
 	if !ok {
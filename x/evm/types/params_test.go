@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/params"
 
 	"github.com/stretchr/testify/require"
@@ -41,6 +43,15 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"evm denom as fee denom",
+			Params{
+				EvmDenom:    "stake",
+				ChainConfig: DefaultChainConfig(),
+				FeeDenoms:   []FeeDenom{{Denom: "stake", ConversionRate: sdk.OneDec()}},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -69,6 +80,37 @@ func TestParamsValidatePriv(t *testing.T) {
 	require.NoError(t, validateBool(true))
 	require.Error(t, validateEIPs(""))
 	require.NoError(t, validateEIPs([]int64{1884}))
+	require.Error(t, validateAllowedDeployers(""))
+	require.Error(t, validateAllowedDeployers([]string{"0x0000000000000000000000000000000000000000"}))
+	require.Error(t, validateAllowedDeployers([]string{"0x00000000000000000000000000000000000000aa", "0x00000000000000000000000000000000000000AA"}))
+	require.NoError(t, validateAllowedDeployers([]string{"0x00000000000000000000000000000000000000aa"}))
+	require.Error(t, validateAllowedCodeHashes(""))
+	require.Error(t, validateAllowedCodeHashes([]string{"0x1234"}))
+	require.Error(t, validateAllowedCodeHashes([]string{common.Hash{1}.Hex(), common.Hash{1}.Hex()}))
+	require.NoError(t, validateAllowedCodeHashes([]string{common.Hash{1}.Hex()}))
+	require.Error(t, validateFeeDenoms(""))
+	require.Error(t, validateFeeDenoms([]FeeDenom{{Denom: "@!#"}}))
+	require.Error(t, validateFeeDenoms([]FeeDenom{{Denom: "uusdc"}, {Denom: "uusdc"}}))
+	require.Error(t, validateFeeDenoms([]FeeDenom{{Denom: "uusdc", ConversionRate: sdk.NewDec(-1)}}))
+	require.NoError(t, validateFeeDenoms([]FeeDenom{{Denom: "uusdc"}, {Denom: "uatom", ConversionRate: sdk.NewDec(2)}}))
+}
+
+func TestParamsValidateCreate(t *testing.T) {
+	deployer := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	codeHash := common.Hash{1}
+
+	params := DefaultParams()
+	require.False(t, params.HasCreateAllowlist())
+	require.NoError(t, params.ValidateCreate(deployer, codeHash))
+
+	params.AllowedDeployers = []string{deployer.Hex()}
+	require.True(t, params.HasCreateAllowlist())
+	require.NoError(t, params.ValidateCreate(deployer, codeHash))
+	require.ErrorIs(t, params.ValidateCreate(common.Address{1}, codeHash), ErrCreateNotAllowed)
+
+	params.AllowedCodeHashes = []string{codeHash.Hex()}
+	require.NoError(t, params.ValidateCreate(deployer, codeHash))
+	require.ErrorIs(t, params.ValidateCreate(deployer, common.Hash{2}), ErrCreateNotAllowed)
 }
 
 func TestValidateChainConfig(t *testing.T) {
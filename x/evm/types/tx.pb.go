@@ -295,6 +295,48 @@ func (m *ExtensionOptionsEthereumTx) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExtensionOptionsEthereumTx proto.InternalMessageInfo
 
+// ExtensionOptionsEthereumTxExpiry is an optional extension option for ethereum transactions, following
+// ExtensionOptionsEthereumTx, that defines the last block at which the transaction can be included.
+type ExtensionOptionsEthereumTxExpiry struct {
+	// max_block_height is the last block height at which the transaction is valid, 0 for none
+	MaxBlockHeight uint64 `protobuf:"varint,1,opt,name=max_block_height,json=maxBlockHeight,proto3" json:"max_block_height,omitempty"`
+	// max_timestamp is the last block time, in unix seconds, at which the transaction is valid, 0 for none
+	MaxTimestamp uint64 `protobuf:"varint,2,opt,name=max_timestamp,json=maxTimestamp,proto3" json:"max_timestamp,omitempty"`
+}
+
+func (m *ExtensionOptionsEthereumTxExpiry) Reset()         { *m = ExtensionOptionsEthereumTxExpiry{} }
+func (m *ExtensionOptionsEthereumTxExpiry) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionsEthereumTxExpiry) ProtoMessage()    {}
+func (*ExtensionOptionsEthereumTxExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{5}
+}
+func (m *ExtensionOptionsEthereumTxExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionsEthereumTxExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionsEthereumTxExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionsEthereumTxExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionsEthereumTxExpiry.Merge(m, src)
+}
+func (m *ExtensionOptionsEthereumTxExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionsEthereumTxExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionsEthereumTxExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionsEthereumTxExpiry proto.InternalMessageInfo
+
 // MsgEthereumTxResponse defines the Msg/EthereumTx response type.
 type MsgEthereumTxResponse struct {
 	// hash of the ethereum transaction in hex format. This hash differs from the
@@ -317,7 +359,7 @@ func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
 func (m *MsgEthereumTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEthereumTxResponse) ProtoMessage()    {}
 func (*MsgEthereumTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{5}
+	return fileDescriptor_f75ac0a12d075f21, []int{6}
 }
 func (m *MsgEthereumTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +401,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{6}
+	return fileDescriptor_f75ac0a12d075f21, []int{7}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +453,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{7}
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,82 +482,306 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetSystemContract defines a Msg for deploying or replacing a system contract.
+type MsgSetSystemContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract defines the address, code and storage of the system contract.
+	Contract SystemContract `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract"`
+	// reset_storage defines if the existing storage of the contract is deleted before
+	// setting the new one. Otherwise the given storage is set on top of the existing one.
+	ResetStorage bool `protobuf:"varint,3,opt,name=reset_storage,json=resetStorage,proto3" json:"reset_storage,omitempty"`
+}
+
+func (m *MsgSetSystemContract) Reset()         { *m = MsgSetSystemContract{} }
+func (m *MsgSetSystemContract) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSystemContract) ProtoMessage()    {}
+func (*MsgSetSystemContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
+}
+func (m *MsgSetSystemContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSystemContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSystemContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSystemContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSystemContract.Merge(m, src)
+}
+func (m *MsgSetSystemContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSystemContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSystemContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSystemContract proto.InternalMessageInfo
+
+func (m *MsgSetSystemContract) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetSystemContract) GetContract() SystemContract {
+	if m != nil {
+		return m.Contract
+	}
+	return SystemContract{}
+}
+
+func (m *MsgSetSystemContract) GetResetStorage() bool {
+	if m != nil {
+		return m.ResetStorage
+	}
+	return false
+}
+
+// MsgSetSystemContractResponse defines the response structure for executing a
+// MsgSetSystemContract message.
+type MsgSetSystemContractResponse struct {
+}
+
+func (m *MsgSetSystemContractResponse) Reset()         { *m = MsgSetSystemContractResponse{} }
+func (m *MsgSetSystemContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSystemContractResponse) ProtoMessage()    {}
+func (*MsgSetSystemContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
+}
+func (m *MsgSetSystemContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSystemContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSystemContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSystemContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSystemContractResponse.Merge(m, src)
+}
+func (m *MsgSetSystemContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSystemContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSystemContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSystemContractResponse proto.InternalMessageInfo
+
+// MsgUpdateBlockedAddresses defines a Msg for updating the addresses which are not
+// allowed to send or receive EVM value.
+type MsgUpdateBlockedAddresses struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// block defines the hex addresses to add to the blocklist.
+	Block []string `protobuf:"bytes,2,rep,name=block,proto3" json:"block,omitempty"`
+	// unblock defines the hex addresses to remove from the blocklist.
+	Unblock []string `protobuf:"bytes,3,rep,name=unblock,proto3" json:"unblock,omitempty"`
+}
+
+func (m *MsgUpdateBlockedAddresses) Reset()         { *m = MsgUpdateBlockedAddresses{} }
+func (m *MsgUpdateBlockedAddresses) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlockedAddresses) ProtoMessage()    {}
+func (*MsgUpdateBlockedAddresses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{11}
+}
+func (m *MsgUpdateBlockedAddresses) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlockedAddresses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlockedAddresses.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBlockedAddresses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlockedAddresses.Merge(m, src)
+}
+func (m *MsgUpdateBlockedAddresses) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlockedAddresses) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlockedAddresses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBlockedAddresses proto.InternalMessageInfo
+
+func (m *MsgUpdateBlockedAddresses) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateBlockedAddresses) GetBlock() []string {
+	if m != nil {
+		return m.Block
+	}
+	return nil
+}
+
+func (m *MsgUpdateBlockedAddresses) GetUnblock() []string {
+	if m != nil {
+		return m.Unblock
+	}
+	return nil
+}
+
+// MsgUpdateBlockedAddressesResponse defines the response structure for executing a
+// MsgUpdateBlockedAddresses message.
+type MsgUpdateBlockedAddressesResponse struct {
+}
+
+func (m *MsgUpdateBlockedAddressesResponse) Reset()         { *m = MsgUpdateBlockedAddressesResponse{} }
+func (m *MsgUpdateBlockedAddressesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBlockedAddressesResponse) ProtoMessage()    {}
+func (*MsgUpdateBlockedAddressesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{12}
+}
+func (m *MsgUpdateBlockedAddressesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateBlockedAddressesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateBlockedAddressesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateBlockedAddressesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBlockedAddressesResponse.Merge(m, src)
+}
+func (m *MsgUpdateBlockedAddressesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateBlockedAddressesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBlockedAddressesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateBlockedAddressesResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
 	proto.RegisterType((*AccessListTx)(nil), "ethermint.evm.v1.AccessListTx")
 	proto.RegisterType((*DynamicFeeTx)(nil), "ethermint.evm.v1.DynamicFeeTx")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "ethermint.evm.v1.ExtensionOptionsEthereumTx")
+	proto.RegisterType((*ExtensionOptionsEthereumTxExpiry)(nil), "ethermint.evm.v1.ExtensionOptionsEthereumTxExpiry")
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetSystemContract)(nil), "ethermint.evm.v1.MsgSetSystemContract")
+	proto.RegisterType((*MsgSetSystemContractResponse)(nil), "ethermint.evm.v1.MsgSetSystemContractResponse")
+	proto.RegisterType((*MsgUpdateBlockedAddresses)(nil), "ethermint.evm.v1.MsgUpdateBlockedAddresses")
+	proto.RegisterType((*MsgUpdateBlockedAddressesResponse)(nil), "ethermint.evm.v1.MsgUpdateBlockedAddressesResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 975 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x8f, 0xdb, 0xc4,
-	0x17, 0x8f, 0x13, 0xe7, 0xd7, 0x24, 0xdf, 0xfd, 0x56, 0xa3, 0xad, 0xea, 0x44, 0x34, 0x0e, 0x96,
-	0x80, 0xb4, 0x52, 0x6c, 0x75, 0x41, 0x3d, 0xec, 0xa9, 0x9b, 0xee, 0xb6, 0x6a, 0xb5, 0x2b, 0x2a,
-	0x93, 0x5e, 0x28, 0x52, 0x34, 0x6b, 0xcf, 0x4e, 0x2c, 0x62, 0x8f, 0xe5, 0x99, 0x58, 0x09, 0x12,
-	0x97, 0x9e, 0xb8, 0x01, 0xe2, 0x1f, 0xe0, 0xc0, 0x89, 0x13, 0x12, 0xfd, 0x03, 0x38, 0x56, 0x9c,
-	0x2a, 0xb8, 0x20, 0x0e, 0x01, 0x65, 0x91, 0x90, 0xf6, 0x06, 0x7f, 0x01, 0x9a, 0x19, 0x67, 0xb3,
-	0x69, 0xd8, 0x16, 0x4a, 0x11, 0x27, 0xcf, 0x9b, 0xf7, 0xe6, 0xbd, 0x37, 0x9f, 0xcf, 0x67, 0x66,
-	0x0c, 0x1a, 0x98, 0x0f, 0x71, 0x12, 0x06, 0x11, 0x77, 0x70, 0x1a, 0x3a, 0xe9, 0x35, 0x87, 0x4f,
-	0xec, 0x38, 0xa1, 0x9c, 0xc2, 0x0b, 0xa7, 0x2e, 0x1b, 0xa7, 0xa1, 0x9d, 0x5e, 0x6b, 0x5e, 0xf2,
-	0x28, 0x0b, 0x29, 0x73, 0x42, 0x46, 0x44, 0x64, 0xc8, 0x88, 0x0a, 0x6d, 0x36, 0x94, 0x63, 0x20,
-	0x2d, 0x47, 0x19, 0x99, 0xab, 0xb9, 0x56, 0x40, 0x24, 0x53, 0xbe, 0x4d, 0x42, 0x09, 0x55, 0x6b,
-	0xc4, 0x28, 0x9b, 0x7d, 0x85, 0x50, 0x4a, 0x46, 0xd8, 0x41, 0x71, 0xe0, 0xa0, 0x28, 0xa2, 0x1c,
-	0xf1, 0x80, 0x46, 0x8b, 0x7c, 0x8d, 0xcc, 0x2b, 0xad, 0xc3, 0xf1, 0x91, 0x83, 0xa2, 0xa9, 0x72,
-	0x59, 0x1f, 0x6b, 0xe0, 0x7f, 0x07, 0x8c, 0xec, 0x89, 0x82, 0x78, 0x1c, 0xf6, 0x27, 0xb0, 0x03,
-	0x74, 0x1f, 0x71, 0x64, 0x68, 0x6d, 0xad, 0x53, 0xdb, 0xda, 0xb4, 0xd5, 0x5a, 0x7b, 0xb1, 0xd6,
-	0xde, 0x89, 0xa6, 0xae, 0x8c, 0x80, 0x0d, 0xa0, 0xb3, 0xe0, 0x03, 0x6c, 0xe4, 0xdb, 0x5a, 0x47,
-	0xeb, 0x15, 0x4f, 0x66, 0xa6, 0xd6, 0x75, 0xe5, 0x14, 0x34, 0x81, 0x3e, 0x44, 0x6c, 0x68, 0x14,
-	0xda, 0x5a, 0xa7, 0xda, 0xab, 0xfd, 0x3e, 0x33, 0xcb, 0xc9, 0x28, 0xde, 0xb6, 0xba, 0x96, 0x2b,
-	0x1d, 0x10, 0x02, 0xfd, 0x28, 0xa1, 0xa1, 0xa1, 0x8b, 0x00, 0x57, 0x8e, 0xb7, 0xf5, 0x8f, 0x3e,
-	0x37, 0x73, 0xd6, 0xd7, 0x79, 0x50, 0xd9, 0xc7, 0x04, 0x79, 0xd3, 0xfe, 0x04, 0x6e, 0x82, 0x62,
-	0x44, 0x23, 0x0f, 0xcb, 0x6e, 0x74, 0x57, 0x19, 0xf0, 0x36, 0xa8, 0x12, 0x24, 0x90, 0x0b, 0x3c,
-	0x55, 0xbd, 0xda, 0xbb, 0xfa, 0xe3, 0xcc, 0x7c, 0x9d, 0x04, 0x7c, 0x38, 0x3e, 0xb4, 0x3d, 0x1a,
-	0x66, 0x78, 0x66, 0x9f, 0x2e, 0xf3, 0xdf, 0x77, 0xf8, 0x34, 0xc6, 0xcc, 0xbe, 0x13, 0x71, 0xb7,
-	0x42, 0x10, 0xbb, 0x27, 0xd6, 0xc2, 0x16, 0x28, 0x10, 0xc4, 0x64, 0x97, 0x7a, 0xaf, 0x3e, 0x9f,
-	0x99, 0x95, 0xdb, 0x88, 0xed, 0x07, 0x61, 0xc0, 0x5d, 0xe1, 0x80, 0x1b, 0x20, 0xcf, 0x69, 0xd6,
-	0x63, 0x9e, 0x53, 0x78, 0x17, 0x14, 0x53, 0x34, 0x1a, 0x63, 0xa3, 0x28, 0x8b, 0xbe, 0xf5, 0xd7,
-	0x8b, 0xce, 0x67, 0x66, 0x69, 0x27, 0xa4, 0xe3, 0x88, 0xbb, 0x2a, 0x85, 0x40, 0x40, 0xe2, 0x5c,
-	0x6a, 0x6b, 0x9d, 0x7a, 0x86, 0x68, 0x1d, 0x68, 0xa9, 0x51, 0x96, 0x13, 0x5a, 0x2a, 0xac, 0xc4,
-	0xa8, 0x28, 0x2b, 0x11, 0x16, 0x33, 0xaa, 0xca, 0x62, 0xdb, 0x1b, 0x02, 0xab, 0x6f, 0x1f, 0x75,
-	0x4b, 0xfd, 0xc9, 0x2e, 0xe2, 0xc8, 0xfa, 0xad, 0x00, 0xea, 0x3b, 0x9e, 0x87, 0x19, 0xdb, 0x0f,
-	0x18, 0xef, 0x4f, 0xe0, 0x03, 0x50, 0xf1, 0x86, 0x28, 0x88, 0x06, 0x81, 0x2f, 0xc1, 0xab, 0xf6,
-	0x6e, 0xfc, 0xad, 0x6e, 0xcb, 0x37, 0xc5, 0xea, 0x3b, 0xbb, 0x27, 0x33, 0xb3, 0xec, 0xa9, 0xa1,
-	0x9b, 0x0d, 0xfc, 0x25, 0x2d, 0xf9, 0x73, 0x69, 0x29, 0xfc, 0x73, 0x5a, 0xf4, 0x67, 0xd3, 0x52,
-	0x5c, 0xa7, 0xa5, 0xf4, 0xf2, 0x68, 0x29, 0x9f, 0xa1, 0xe5, 0x01, 0xa8, 0x20, 0x89, 0x2d, 0x66,
-	0x46, 0xa5, 0x5d, 0xe8, 0xd4, 0xb6, 0x2e, 0xdb, 0x4f, 0x1f, 0x74, 0x5b, 0xa1, 0xdf, 0x1f, 0xc7,
-	0x23, 0xdc, 0x6b, 0x3f, 0x9e, 0x99, 0xb9, 0x93, 0x99, 0x09, 0xd0, 0x29, 0x25, 0x5f, 0xfe, 0x64,
-	0x82, 0x25, 0x41, 0xee, 0x69, 0x42, 0xc5, 0x79, 0x75, 0x85, 0x73, 0xb0, 0xc2, 0x79, 0xed, 0x3c,
-	0xce, 0xbf, 0xd1, 0x41, 0x7d, 0x77, 0x1a, 0xa1, 0x30, 0xf0, 0x6e, 0x61, 0xfc, 0xdf, 0x70, 0x7e,
-	0x17, 0xd4, 0x04, 0xe7, 0x3c, 0x88, 0x07, 0x1e, 0x8a, 0x5f, 0x80, 0x75, 0x21, 0x99, 0x7e, 0x10,
-	0xdf, 0x44, 0xf1, 0x22, 0xd7, 0x11, 0xc6, 0x32, 0x97, 0xfe, 0x42, 0xb9, 0x6e, 0x61, 0x2c, 0x72,
-	0x65, 0x12, 0x2a, 0x3e, 0x5b, 0x42, 0xa5, 0x75, 0x09, 0x95, 0x5f, 0x9e, 0x84, 0x2a, 0xe7, 0x48,
-	0xa8, 0xfa, 0xaf, 0x48, 0x08, 0xac, 0x48, 0xa8, 0xb6, 0x22, 0xa1, 0xfa, 0x79, 0x12, 0xb2, 0x40,
-	0x73, 0x6f, 0xc2, 0x71, 0xc4, 0x02, 0x1a, 0xbd, 0x1d, 0xcb, 0x37, 0x63, 0xf9, 0x14, 0x64, 0x17,
-	0xf2, 0x17, 0x1a, 0xb8, 0xb8, 0xf2, 0x44, 0xb8, 0x98, 0xc5, 0x34, 0x62, 0x72, 0xa3, 0xf2, 0x96,
-	0xd7, 0xd4, 0x25, 0x2e, 0x2f, 0xf6, 0x2b, 0x40, 0x1f, 0x51, 0xc2, 0x8c, 0xbc, 0xdc, 0xe4, 0xc5,
-	0xf5, 0x4d, 0xee, 0x53, 0xe2, 0xca, 0x10, 0x78, 0x01, 0x14, 0x12, 0xcc, 0xa5, 0x66, 0xea, 0xae,
-	0x18, 0xc2, 0x06, 0xa8, 0xa4, 0xe1, 0x00, 0x27, 0x09, 0x4d, 0xb2, 0x5b, 0xb7, 0x9c, 0x86, 0x7b,
-	0xc2, 0x14, 0x2e, 0x21, 0x8e, 0x31, 0xc3, 0xbe, 0x62, 0xd5, 0x2d, 0x13, 0xc4, 0xee, 0x33, 0xec,
-	0x67, 0x6d, 0x7e, 0xaa, 0x81, 0xff, 0x1f, 0x30, 0x72, 0x3f, 0xf6, 0x11, 0xc7, 0xf7, 0x50, 0x82,
-	0x42, 0x06, 0xaf, 0x83, 0x2a, 0x1a, 0xf3, 0x21, 0x4d, 0x02, 0x3e, 0xcd, 0x4e, 0x84, 0xf1, 0xdd,
-	0xa3, 0xee, 0x66, 0xf6, 0xda, 0xee, 0xf8, 0x7e, 0x82, 0x19, 0x7b, 0x87, 0x27, 0x41, 0x44, 0xdc,
-	0x65, 0x28, 0xbc, 0x0e, 0x4a, 0xb1, 0xcc, 0x20, 0xc5, 0x5e, 0xdb, 0x32, 0xd6, 0xb7, 0xa1, 0x2a,
-	0xf4, 0x74, 0x41, 0x93, 0x9b, 0x45, 0x6f, 0x6f, 0x3c, 0xfc, 0xf5, 0xab, 0xab, 0xcb, 0x3c, 0x56,
-	0x03, 0x5c, 0x7a, 0xaa, 0xa5, 0x05, 0x76, 0x5b, 0x73, 0x0d, 0x14, 0x0e, 0x18, 0x81, 0x1f, 0x02,
-	0x70, 0xe6, 0xf1, 0x35, 0xd7, 0x0b, 0xad, 0x40, 0xdf, 0x7c, 0xe3, 0x39, 0x01, 0x8b, 0xfc, 0xd6,
-	0x6b, 0x0f, 0xbf, 0xff, 0xe5, 0xb3, 0xbc, 0x69, 0x5d, 0x76, 0xd6, 0x7f, 0x26, 0xb2, 0xe8, 0x01,
-	0x9f, 0xc0, 0xf7, 0x40, 0x7d, 0x05, 0xb1, 0x57, 0xff, 0x34, 0xff, 0xd9, 0x90, 0xe6, 0x95, 0xe7,
-	0x86, 0x2c, 0x9a, 0xe8, 0xdd, 0x78, 0x3c, 0x6f, 0x69, 0x4f, 0xe6, 0x2d, 0xed, 0xe7, 0x79, 0x4b,
-	0xfb, 0xe4, 0xb8, 0x95, 0x7b, 0x72, 0xdc, 0xca, 0xfd, 0x70, 0xdc, 0xca, 0xbd, 0x7b, 0xf6, 0x70,
-	0xe1, 0x54, 0x9c, 0xad, 0x65, 0x9b, 0x13, 0xd9, 0xa8, 0x3c, 0x60, 0x87, 0x25, 0xf9, 0xdf, 0xf1,
-	0xe6, 0x1f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x8d, 0xf3, 0xe0, 0xc6, 0x74, 0x09, 0x00, 0x00,
+	// 1176 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x5f, 0x27, 0xce, 0xbf, 0x97, 0x74, 0x29, 0x56, 0x4a, 0x9d, 0xa8, 0x8d, 0xd3, 0x54, 0x94,
+	0xb4, 0x68, 0x13, 0x75, 0x8b, 0x7a, 0xd8, 0x53, 0x37, 0xed, 0xb6, 0xb4, 0xda, 0x15, 0x95, 0x37,
+	0xbd, 0x50, 0xa4, 0x68, 0xd6, 0x99, 0x3a, 0x56, 0x63, 0x8f, 0xe5, 0x99, 0x04, 0xa7, 0x12, 0x97,
+	0x9e, 0xb8, 0x01, 0xea, 0x17, 0xe0, 0xc0, 0x89, 0x13, 0x12, 0xfd, 0x00, 0xdc, 0xa8, 0xe0, 0x52,
+	0xc1, 0x05, 0x71, 0x08, 0x68, 0x8b, 0x84, 0xd4, 0x1b, 0x7c, 0x02, 0x34, 0xe3, 0x49, 0xb2, 0x59,
+	0x6f, 0xb6, 0xed, 0x52, 0xc4, 0x29, 0xf3, 0xfe, 0xcc, 0x7b, 0xcf, 0xbf, 0xdf, 0xcf, 0x79, 0x86,
+	0x12, 0x66, 0x3d, 0x1c, 0xb8, 0x8e, 0xc7, 0x9a, 0x78, 0xe8, 0x36, 0x87, 0x17, 0x9b, 0x2c, 0x6c,
+	0xf8, 0x01, 0x61, 0x44, 0x3b, 0x3e, 0x0d, 0x35, 0xf0, 0xd0, 0x6d, 0x0c, 0x2f, 0x96, 0x4f, 0x5a,
+	0x84, 0xba, 0x84, 0x36, 0x5d, 0x6a, 0xf3, 0x4c, 0x97, 0xda, 0x51, 0x6a, 0xb9, 0x14, 0x05, 0x3a,
+	0xc2, 0x6a, 0x46, 0x86, 0x0c, 0x95, 0x63, 0x0d, 0x78, 0xb1, 0x28, 0x56, 0xb4, 0x89, 0x4d, 0xa2,
+	0x3b, 0xfc, 0x24, 0xbd, 0xa7, 0x6c, 0x42, 0xec, 0x3e, 0x6e, 0x22, 0xdf, 0x69, 0x22, 0xcf, 0x23,
+	0x0c, 0x31, 0x87, 0x78, 0x93, 0x7a, 0x25, 0x19, 0x15, 0xd6, 0xce, 0xe0, 0x5e, 0x13, 0x79, 0xa3,
+	0x28, 0x54, 0xfb, 0x4c, 0x81, 0x63, 0x5b, 0xd4, 0xde, 0xe0, 0x0d, 0xf1, 0xc0, 0x6d, 0x87, 0x5a,
+	0x1d, 0xd4, 0x2e, 0x62, 0x48, 0x57, 0xaa, 0x4a, 0x3d, 0xbf, 0x5a, 0x6c, 0x44, 0x77, 0x1b, 0x93,
+	0xbb, 0x8d, 0x75, 0x6f, 0x64, 0x8a, 0x0c, 0xad, 0x04, 0x2a, 0x75, 0x1e, 0x60, 0x3d, 0x51, 0x55,
+	0xea, 0x4a, 0x2b, 0xf5, 0x7c, 0x6c, 0x28, 0x2b, 0xa6, 0x70, 0x69, 0x06, 0xa8, 0x3d, 0x44, 0x7b,
+	0x7a, 0xb2, 0xaa, 0xd4, 0x73, 0xad, 0xfc, 0xdf, 0x63, 0x23, 0x13, 0xf4, 0xfd, 0xb5, 0xda, 0x4a,
+	0xcd, 0x14, 0x01, 0x4d, 0x03, 0xf5, 0x5e, 0x40, 0x5c, 0x5d, 0xe5, 0x09, 0xa6, 0x38, 0xaf, 0xa9,
+	0x9f, 0x7e, 0x69, 0x2c, 0xd5, 0xbe, 0x4d, 0x40, 0x76, 0x13, 0xdb, 0xc8, 0x1a, 0xb5, 0x43, 0xad,
+	0x08, 0x29, 0x8f, 0x78, 0x16, 0x16, 0xd3, 0xa8, 0x66, 0x64, 0x68, 0x37, 0x20, 0x67, 0x23, 0x8e,
+	0x9c, 0x63, 0x45, 0xdd, 0x73, 0xad, 0x0b, 0xbf, 0x8e, 0x8d, 0x73, 0xb6, 0xc3, 0x7a, 0x83, 0x9d,
+	0x86, 0x45, 0x5c, 0x89, 0xa7, 0xfc, 0x59, 0xa1, 0xdd, 0xfb, 0x4d, 0x36, 0xf2, 0x31, 0x6d, 0xdc,
+	0xf4, 0x98, 0x99, 0xb5, 0x11, 0xbd, 0xcd, 0xef, 0x6a, 0x15, 0x48, 0xda, 0x88, 0x8a, 0x29, 0xd5,
+	0x56, 0x61, 0x77, 0x6c, 0x64, 0x6f, 0x20, 0xba, 0xe9, 0xb8, 0x0e, 0x33, 0x79, 0x40, 0x5b, 0x86,
+	0x04, 0x23, 0x72, 0xc6, 0x04, 0x23, 0xda, 0x2d, 0x48, 0x0d, 0x51, 0x7f, 0x80, 0xf5, 0x94, 0x68,
+	0xfa, 0xde, 0xcb, 0x37, 0xdd, 0x1d, 0x1b, 0xe9, 0x75, 0x97, 0x0c, 0x3c, 0x66, 0x46, 0x25, 0x38,
+	0x02, 0x02, 0xe7, 0x74, 0x55, 0xa9, 0x17, 0x24, 0xa2, 0x05, 0x50, 0x86, 0x7a, 0x46, 0x38, 0x94,
+	0x21, 0xb7, 0x02, 0x3d, 0x1b, 0x59, 0x01, 0xb7, 0xa8, 0x9e, 0x8b, 0x2c, 0xba, 0xb6, 0xcc, 0xb1,
+	0xfa, 0xe1, 0xf1, 0x4a, 0xba, 0x1d, 0x5e, 0x43, 0x0c, 0xd5, 0xfe, 0x4a, 0x42, 0x61, 0xdd, 0xb2,
+	0x30, 0xa5, 0x9b, 0x0e, 0x65, 0xed, 0x50, 0xbb, 0x0b, 0x59, 0xab, 0x87, 0x1c, 0xaf, 0xe3, 0x74,
+	0x05, 0x78, 0xb9, 0xd6, 0x95, 0x57, 0x9a, 0x36, 0x73, 0x95, 0xdf, 0xbe, 0x79, 0xed, 0xf9, 0xd8,
+	0xc8, 0x58, 0xd1, 0xd1, 0x94, 0x87, 0xee, 0x8c, 0x96, 0xc4, 0x42, 0x5a, 0x92, 0xff, 0x9e, 0x16,
+	0xf5, 0x70, 0x5a, 0x52, 0x71, 0x5a, 0xd2, 0xaf, 0x8f, 0x96, 0xcc, 0x1e, 0x5a, 0xee, 0x42, 0x16,
+	0x09, 0x6c, 0x31, 0xd5, 0xb3, 0xd5, 0x64, 0x3d, 0xbf, 0x7a, 0xba, 0xb1, 0xff, 0x45, 0x6f, 0x44,
+	0xe8, 0xb7, 0x07, 0x7e, 0x1f, 0xb7, 0xaa, 0x4f, 0xc6, 0xc6, 0xd2, 0xf3, 0xb1, 0x01, 0x68, 0x4a,
+	0xc9, 0xd7, 0xbf, 0x19, 0x30, 0x23, 0xc8, 0x9c, 0x16, 0x8c, 0x38, 0xcf, 0xcd, 0x71, 0x0e, 0x73,
+	0x9c, 0xe7, 0x17, 0x71, 0xfe, 0x9d, 0x0a, 0x85, 0x6b, 0x23, 0x0f, 0xb9, 0x8e, 0x75, 0x1d, 0xe3,
+	0xff, 0x87, 0xf3, 0x5b, 0x90, 0xe7, 0x9c, 0x33, 0xc7, 0xef, 0x58, 0xc8, 0x3f, 0x02, 0xeb, 0x5c,
+	0x32, 0x6d, 0xc7, 0xbf, 0x8a, 0xfc, 0x49, 0xad, 0x7b, 0x18, 0x8b, 0x5a, 0xea, 0x91, 0x6a, 0x5d,
+	0xc7, 0x98, 0xd7, 0x92, 0x12, 0x4a, 0x1d, 0x2e, 0xa1, 0x74, 0x5c, 0x42, 0x99, 0xd7, 0x27, 0xa1,
+	0xec, 0x02, 0x09, 0xe5, 0xfe, 0x13, 0x09, 0xc1, 0x9c, 0x84, 0xf2, 0x73, 0x12, 0x2a, 0x2c, 0x92,
+	0x50, 0x0d, 0xca, 0x1b, 0x21, 0xc3, 0x1e, 0x75, 0x88, 0xf7, 0x81, 0x2f, 0x76, 0xc6, 0x6c, 0x15,
+	0xc8, 0x3f, 0xe4, 0x8f, 0xa1, 0xba, 0x38, 0x67, 0x23, 0xf4, 0x9d, 0x60, 0xa4, 0xd5, 0xe1, 0xb8,
+	0x8b, 0xc2, 0xce, 0x4e, 0x9f, 0x58, 0xf7, 0x3b, 0x3d, 0xec, 0xd8, 0x3d, 0x26, 0xff, 0xb2, 0x97,
+	0x5d, 0x14, 0xb6, 0xb8, 0xfb, 0x7d, 0xe1, 0xd5, 0xce, 0xc2, 0x31, 0x9e, 0xc9, 0x1c, 0x17, 0x53,
+	0x86, 0x5c, 0x5f, 0xca, 0xa9, 0xe0, 0xa2, 0xb0, 0x3d, 0xf1, 0xc9, 0xc6, 0x5f, 0x29, 0x70, 0x62,
+	0x6e, 0x37, 0x99, 0x98, 0xfa, 0xc4, 0xa3, 0x02, 0x61, 0xb1, 0x5e, 0x94, 0x68, 0x7b, 0xf0, 0xb3,
+	0x76, 0x1e, 0xd4, 0x3e, 0xb1, 0xa9, 0x9e, 0x10, 0xe8, 0x9e, 0x88, 0xa3, 0xbb, 0x49, 0x6c, 0x53,
+	0xa4, 0x68, 0xc7, 0x21, 0x19, 0x60, 0x26, 0xc4, 0x5a, 0x30, 0xf9, 0x51, 0x2b, 0x41, 0x76, 0xe8,
+	0x76, 0x70, 0x10, 0x90, 0x40, 0xfe, 0xdd, 0x67, 0x86, 0xee, 0x06, 0x37, 0x79, 0x88, 0xab, 0x72,
+	0x40, 0x71, 0x37, 0x92, 0x93, 0x99, 0xb1, 0x11, 0xbd, 0x43, 0x71, 0x57, 0x8e, 0xf9, 0x85, 0x02,
+	0x6f, 0x6c, 0x51, 0xfb, 0x8e, 0xdf, 0x45, 0x0c, 0xdf, 0x46, 0x01, 0x72, 0xa9, 0x76, 0x19, 0x72,
+	0x68, 0xc0, 0x7a, 0x24, 0x70, 0xd8, 0x48, 0xbe, 0x8a, 0xfa, 0x4f, 0x8f, 0x57, 0x8a, 0x72, 0xcd,
+	0xaf, 0x77, 0xbb, 0x01, 0xa6, 0x74, 0x9b, 0x05, 0x8e, 0x67, 0x9b, 0xb3, 0x54, 0xed, 0x32, 0xa4,
+	0x7d, 0x51, 0x41, 0xc0, 0x92, 0x5f, 0xd5, 0xe3, 0x8f, 0x11, 0x75, 0x68, 0xa9, 0x5c, 0x1f, 0xa6,
+	0xcc, 0x5e, 0x5b, 0x7e, 0xf8, 0xe7, 0x37, 0x17, 0x66, 0x75, 0x6a, 0x25, 0x38, 0xb9, 0x6f, 0xa4,
+	0x09, 0x76, 0xb5, 0xef, 0x15, 0x28, 0x6e, 0x51, 0x7b, 0x1b, 0xb3, 0xed, 0x11, 0x65, 0xd8, 0xbd,
+	0x4a, 0x3c, 0x16, 0x20, 0x8b, 0x1d, 0x79, 0xe6, 0x16, 0x64, 0x2d, 0x59, 0x43, 0x4e, 0x5d, 0x8d,
+	0x4f, 0x3d, 0xdf, 0x4b, 0x4e, 0x3f, 0xbd, 0xc7, 0x55, 0x11, 0x60, 0x8a, 0x59, 0x87, 0x32, 0x12,
+	0x20, 0x3b, 0x5a, 0x1f, 0x59, 0xb3, 0x20, 0x9c, 0xdb, 0x91, 0x2f, 0xf6, 0x90, 0x15, 0x38, 0x75,
+	0xd0, 0x83, 0x4c, 0x9f, 0xf4, 0x91, 0x02, 0xa5, 0x29, 0x0a, 0x42, 0x83, 0xb8, 0x2b, 0x9f, 0x02,
+	0x1f, 0x9d, 0xa2, 0x22, 0xa4, 0x84, 0xcc, 0x85, 0xd0, 0x72, 0x66, 0x64, 0x68, 0x3a, 0x64, 0x06,
+	0x5e, 0xe4, 0x4f, 0x0a, 0xff, 0xc4, 0x8c, 0x4d, 0x7d, 0x16, 0xce, 0x2c, 0x1c, 0x6a, 0x32, 0xfa,
+	0xea, 0x8f, 0x49, 0x48, 0x6e, 0x51, 0x5b, 0xfb, 0x04, 0x60, 0xcf, 0xa7, 0x99, 0x11, 0xc7, 0x75,
+	0xee, 0xfd, 0x28, 0xbf, 0xf3, 0x82, 0x84, 0x29, 0x34, 0x6f, 0x3f, 0xfc, 0xf9, 0x8f, 0x47, 0x09,
+	0xa3, 0x76, 0xba, 0x19, 0xff, 0xd4, 0x94, 0xd9, 0x1d, 0x16, 0x6a, 0x1f, 0x41, 0x61, 0x4e, 0xd6,
+	0x67, 0x0e, 0xac, 0xbf, 0x37, 0xa5, 0x7c, 0xfe, 0x85, 0x29, 0xd3, 0xb7, 0xf8, 0x3e, 0xbc, 0x19,
+	0x57, 0xe1, 0xb9, 0x03, 0xef, 0xc7, 0xf2, 0xca, 0x8d, 0x97, 0xcb, 0x9b, 0x36, 0x7b, 0x00, 0x6f,
+	0x2d, 0x10, 0xc2, 0xbb, 0x87, 0x4c, 0xbc, 0x3f, 0xb9, 0x7c, 0xe9, 0x15, 0x92, 0x27, 0xbd, 0x5b,
+	0x57, 0x9e, 0xec, 0x56, 0x94, 0xa7, 0xbb, 0x15, 0xe5, 0xf7, 0xdd, 0x8a, 0xf2, 0xf9, 0xb3, 0xca,
+	0xd2, 0xd3, 0x67, 0x95, 0xa5, 0x5f, 0x9e, 0x55, 0x96, 0x3e, 0xdc, 0xbb, 0x63, 0xf0, 0x90, 0xaf,
+	0x98, 0x19, 0x1f, 0xa1, 0x60, 0x44, 0xec, 0x99, 0x9d, 0xb4, 0xf8, 0xfc, 0xbe, 0xf4, 0xcf, 0x00,
+	0x67, 0x0a, 0x0b, 0x21, 0x7b, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -535,6 +801,12 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetSystemContract defines a governance operation for deploying or replacing the code and
+	// storage of a system contract. The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetSystemContract(ctx context.Context, in *MsgSetSystemContract, opts ...grpc.CallOption) (*MsgSetSystemContractResponse, error)
+	// UpdateBlockedAddresses defines a governance operation for adding and removing addresses
+	// from the blocklist. The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateBlockedAddresses(ctx context.Context, in *MsgUpdateBlockedAddresses, opts ...grpc.CallOption) (*MsgUpdateBlockedAddressesResponse, error)
 }
 
 type msgClient struct {
@@ -563,6 +835,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetSystemContract(ctx context.Context, in *MsgSetSystemContract, opts ...grpc.CallOption) (*MsgSetSystemContractResponse, error) {
+	out := new(MsgSetSystemContractResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/SetSystemContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateBlockedAddresses(ctx context.Context, in *MsgUpdateBlockedAddresses, opts ...grpc.CallOption) (*MsgUpdateBlockedAddressesResponse, error) {
+	out := new(MsgUpdateBlockedAddressesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/UpdateBlockedAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -570,6 +860,12 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetSystemContract defines a governance operation for deploying or replacing the code and
+	// storage of a system contract. The authority is hard-coded to the Cosmos SDK x/gov module account
+	SetSystemContract(context.Context, *MsgSetSystemContract) (*MsgSetSystemContractResponse, error)
+	// UpdateBlockedAddresses defines a governance operation for adding and removing addresses
+	// from the blocklist. The authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdateBlockedAddresses(context.Context, *MsgUpdateBlockedAddresses) (*MsgUpdateBlockedAddressesResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -582,6 +878,12 @@ func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumT
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetSystemContract(ctx context.Context, req *MsgSetSystemContract) (*MsgSetSystemContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSystemContract not implemented")
+}
+func (*UnimplementedMsgServer) UpdateBlockedAddresses(ctx context.Context, req *MsgUpdateBlockedAddresses) (*MsgUpdateBlockedAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBlockedAddresses not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -623,6 +925,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetSystemContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSystemContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSystemContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/SetSystemContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSystemContract(ctx, req.(*MsgSetSystemContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateBlockedAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBlockedAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBlockedAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/UpdateBlockedAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBlockedAddresses(ctx, req.(*MsgUpdateBlockedAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -635,6 +973,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetSystemContract",
+			Handler:    _Msg_SetSystemContract_Handler,
+		},
+		{
+			MethodName: "UpdateBlockedAddresses",
+			Handler:    _Msg_UpdateBlockedAddresses_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1058,6 +1404,39 @@ func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ExtensionOptionsEthereumTxExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionsEthereumTxExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionsEthereumTxExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxBlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxBlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1184,29 +1563,173 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetSystemContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetSystemContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSystemContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.ResetStorage {
+		i--
+		if m.ResetStorage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.Size_ != 0 {
-		n += 9
+	{
+		size, err := m.Contract.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSystemContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSystemContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSystemContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlockedAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockedAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockedAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unblock) > 0 {
+		for iNdEx := len(m.Unblock) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Unblock[iNdEx])
+			copy(dAtA[i:], m.Unblock[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Unblock[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Block) > 0 {
+		for iNdEx := len(m.Block) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Block[iNdEx])
+			copy(dAtA[i:], m.Block[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Block[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBlockedAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBlockedAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBlockedAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 9
 	}
 	l = len(m.Hash)
 	if l > 0 {
@@ -1381,6 +1904,21 @@ func (m *ExtensionOptionsEthereumTx) Size() (n int) {
 	return n
 }
 
+func (m *ExtensionOptionsEthereumTxExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.MaxBlockHeight))
+	}
+	if m.MaxTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.MaxTimestamp))
+	}
+	return n
+}
+
 func (m *MsgEthereumTxResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1435,6 +1973,67 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetSystemContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Contract.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ResetStorage {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetSystemContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBlockedAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Block) > 0 {
+		for _, s := range m.Block {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Unblock) > 0 {
+		for _, s := range m.Unblock {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateBlockedAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2812,6 +3411,94 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExtensionOptionsEthereumTxExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTxExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTxExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlockHeight", wireType)
+			}
+			m.MaxBlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTimestamp", wireType)
+			}
+			m.MaxTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -3178,6 +3865,387 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetSystemContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSystemContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSystemContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Contract.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetStorage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetStorage = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSystemContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSystemContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSystemContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBlockedAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBlockedAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBlockedAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Block = append(m.Block, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unblock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unblock = append(m.Unblock, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBlockedAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBlockedAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBlockedAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,66 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// Validate performs a stateless validation of the expiry extension option.
+func (e ExtensionOptionsEthereumTxExpiry) Validate() error {
+	if e.MaxBlockHeight == 0 && e.MaxTimestamp == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "expiry must define a max block height or timestamp")
+	}
+	return nil
+}
+
+// IsExpired returns true if a block with the given height and time is past the expiry.
+func (e ExtensionOptionsEthereumTxExpiry) IsExpired(height int64, blockTime time.Time) bool {
+	if e.MaxBlockHeight > 0 && height > 0 && uint64(height) > e.MaxBlockHeight {
+		return true
+	}
+	if e.MaxTimestamp > 0 && blockTime.Unix() > 0 && uint64(blockTime.Unix()) > e.MaxTimestamp {
+		return true
+	}
+	return false
+}
+
+// GetTxExpiry returns the expiry extension option of an ethereum tx, that can follow its
+// ExtensionOptionsEthereumTx option. It returns nil if the tx doesn't define one.
+func GetTxExpiry(options []*codectypes.Any) (*ExtensionOptionsEthereumTxExpiry, error) {
+	switch len(options) {
+	case 0, 1:
+		return nil, nil
+	case 2:
+		expiry, ok := options[1].GetCachedValue().(*ExtensionOptionsEthereumTxExpiry)
+		if !ok {
+			return nil, errorsmod.Wrapf(
+				errortypes.ErrUnknownExtensionOptions,
+				"invalid extension option %s, expected %T", options[1].GetTypeUrl(), expiry,
+			)
+		}
+		if err := expiry.Validate(); err != nil {
+			return nil, err
+		}
+		return expiry, nil
+	default:
+		return nil, errorsmod.Wrap(errortypes.ErrUnknownExtensionOptions, "too many extension options for ethereum tx")
+	}
+}
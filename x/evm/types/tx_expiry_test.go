@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxExpiryIsExpired(t *testing.T) {
+	blockTime := time.Unix(1000, 0)
+
+	testCases := []struct {
+		name       string
+		expiry     ExtensionOptionsEthereumTxExpiry
+		expExpired bool
+	}{
+		{"no limit", ExtensionOptionsEthereumTxExpiry{}, false},
+		{"max block height not reached", ExtensionOptionsEthereumTxExpiry{MaxBlockHeight: 10}, false},
+		{"max block height passed", ExtensionOptionsEthereumTxExpiry{MaxBlockHeight: 9}, true},
+		{"max timestamp not reached", ExtensionOptionsEthereumTxExpiry{MaxTimestamp: 1000}, false},
+		{"max timestamp passed", ExtensionOptionsEthereumTxExpiry{MaxTimestamp: 999}, true},
+		{"max timestamp passed before max block height", ExtensionOptionsEthereumTxExpiry{MaxBlockHeight: 10, MaxTimestamp: 999}, true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expExpired, tc.expiry.IsExpired(10, blockTime), tc.name)
+	}
+}
+
+func TestGetTxExpiry(t *testing.T) {
+	ethOption, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTx{})
+	require.NoError(t, err)
+	expiry := &ExtensionOptionsEthereumTxExpiry{MaxBlockHeight: 10}
+	expiryOption, err := codectypes.NewAnyWithValue(expiry)
+	require.NoError(t, err)
+	emptyExpiryOption, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTxExpiry{})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		options   []*codectypes.Any
+		expExpiry *ExtensionOptionsEthereumTxExpiry
+		expErr    bool
+	}{
+		{"no expiry", []*codectypes.Any{ethOption}, nil, false},
+		{"expiry", []*codectypes.Any{ethOption, expiryOption}, expiry, false},
+		{"empty expiry", []*codectypes.Any{ethOption, emptyExpiryOption}, nil, true},
+		{"invalid extension option", []*codectypes.Any{ethOption, ethOption}, nil, true},
+		{"too many extension options", []*codectypes.Any{ethOption, expiryOption, expiryOption}, nil, true},
+	}
+
+	for _, tc := range testCases {
+		res, err := GetTxExpiry(tc.options)
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expExpiry, res, tc.name)
+		}
+	}
+}
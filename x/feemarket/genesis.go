@@ -16,6 +16,8 @@
 package feemarket
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -30,6 +32,10 @@ func InitGenesis(
 	k keeper.Keeper,
 	data types.GenesisState,
 ) []abci.ValidatorUpdate {
+	if name := data.Params.BaseFeeCalculatorName(); !k.HasBaseFeeCalculator(name) {
+		panic(fmt.Errorf("unknown base fee calculator %s at genesis", name))
+	}
+
 	err := k.SetParams(ctx, data.Params)
 	if err != nil {
 		panic(errorsmod.Wrap(err, "could not set parameters at genesis"))
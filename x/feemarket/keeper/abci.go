@@ -49,10 +49,20 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 	})
 }
 
-// EndBlock update block gas wanted.
+// EndBlock update block gas wanted and distributes the base fees of the block.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) {
+	// a failed distribution leaves the base fees in the fee collector instead of halting the chain
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.DistributeBaseFees(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to distribute the base fees", "error", err.Error())
+	} else {
+		writeCache()
+	}
+
+	k.UpdateMinGasPriceOracle(ctx)
+
 	if ctx.BlockGasMeter() == nil {
 		k.Logger(ctx).Error("block gas meter is nil when setting block gas wanted")
 		return
@@ -69,6 +79,8 @@ func (k *Keeper) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) {
 	limitedGasWanted := sdk.NewDec(int64(gasWanted)).Mul(minGasMultiplier)
 	gasWanted = sdk.MaxDec(limitedGasWanted, sdk.NewDec(int64(gasUsed))).TruncateInt().Uint64()
 	k.SetBlockGasWanted(ctx, gasWanted)
+	k.SetBlockGasUsed(ctx, gasUsed)
+	k.RecordFeeHistory(ctx, gasWanted, gasUsed)
 
 	defer func() {
 		telemetry.SetGauge(float32(gasWanted), "feemarket", "block_gas")
@@ -0,0 +1,173 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// BaseFeeCalculator defines the algorithm adjusting the base fee between blocks. Calculators are
+// selected by name with the BaseFeeCalculator parameter.
+type BaseFeeCalculator interface {
+	// CalculateBaseFee returns the base fee of the current block given the base fee of the parent
+	// block. The gas consumption of the parent block can be retrieved from the keeper.
+	CalculateBaseFee(ctx sdk.Context, k Keeper, params types.Params, parentBaseFee *big.Int) *big.Int
+}
+
+// defaultBaseFeeCalculators returns the base fee calculators available by default.
+func defaultBaseFeeCalculators() map[string]BaseFeeCalculator {
+	return map[string]BaseFeeCalculator{
+		types.BaseFeeCalculatorEIP1559: EIP1559BaseFeeCalculator{},
+		types.BaseFeeCalculatorGasUsed: GasUsedBaseFeeCalculator{},
+		types.BaseFeeCalculatorAIMD:    AIMDBaseFeeCalculator{},
+		types.BaseFeeCalculatorPID:     PIDBaseFeeCalculator{},
+	}
+}
+
+// RegisterBaseFeeCalculator registers a base fee calculator under the given name, so that it can
+// be selected with the BaseFeeCalculator parameter. It panics if the name is already registered.
+func (k Keeper) RegisterBaseFeeCalculator(name string, calculator BaseFeeCalculator) {
+	if _, found := k.calculators[name]; found {
+		panic(fmt.Errorf("base fee calculator %s already registered", name))
+	}
+	k.calculators[name] = calculator
+}
+
+// HasBaseFeeCalculator returns true if a base fee calculator is registered under the given name.
+func (k Keeper) HasBaseFeeCalculator(name string) bool {
+	_, found := k.calculators[name]
+	return found
+}
+
+// GasUsedBaseFeeCalculator adjusts the base fee following EIP-1559 based on the gas actually used by
+// the parent block instead of its gas wanted.
+type GasUsedBaseFeeCalculator struct{}
+
+// CalculateBaseFee implements BaseFeeCalculator
+func (GasUsedBaseFeeCalculator) CalculateBaseFee(ctx sdk.Context, k Keeper, params types.Params, parentBaseFee *big.Int) *big.Int {
+	return calculateEIP1559BaseFee(ctx, params, parentBaseFee, k.GetBlockGasUsed(ctx))
+}
+
+// AIMDBaseFeeCalculator adds AimdAdditiveIncrease to the base fee when the parent block wanted more
+// gas than its target, and multiplies it by AimdMultiplicativeDecrease otherwise. The result is bounded
+// by MinBaseFee and MaxBaseFee.
+type AIMDBaseFeeCalculator struct{}
+
+// CalculateBaseFee implements BaseFeeCalculator
+func (AIMDBaseFeeCalculator) CalculateBaseFee(ctx sdk.Context, k Keeper, params types.Params, parentBaseFee *big.Int) *big.Int {
+	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
+	// validation
+	gasTarget := new(big.Int).Div(blockGasLimit(ctx), new(big.Int).SetUint64(uint64(params.ElasticityMultiplier)))
+	parentGasWanted := new(big.Int).SetUint64(k.GetBlockGasWanted(ctx))
+
+	var baseFee *big.Int
+	if parentGasWanted.Cmp(gasTarget) > 0 {
+		baseFee = new(big.Int).Add(parentBaseFee, params.AimdAdditiveIncrease.BigInt())
+	} else {
+		baseFee = sdk.NewDecFromBigInt(parentBaseFee).Mul(params.AimdMultiplicativeDecrease).TruncateInt().BigInt()
+	}
+
+	return boundBaseFee(params, baseFee)
+}
+
+// PIDBaseFeeCalculator adjusts the base fee with a PID controller whose error is the difference between
+// the utilization of the parent block (i.e gas wanted over gas limit) and PidTargetUtilization. The base
+// fee is multiplied by 1 + Kp * error + Ki * integral + Kd * derivative, and bounded by MinBaseFee and
+// MaxBaseFee. The integral term is limited to [-1, 1] to prevent windup.
+type PIDBaseFeeCalculator struct{}
+
+// CalculateBaseFee implements BaseFeeCalculator
+func (PIDBaseFeeCalculator) CalculateBaseFee(ctx sdk.Context, k Keeper, params types.Params, parentBaseFee *big.Int) *big.Int {
+	gasWanted := sdk.NewDecFromBigInt(new(big.Int).SetUint64(k.GetBlockGasWanted(ctx)))
+	utilization := sdk.MinDec(gasWanted.Quo(sdk.NewDecFromBigInt(blockGasLimit(ctx))), sdk.OneDec())
+
+	err := utilization.Sub(params.PidTargetUtilization)
+	integral := sdk.MaxDec(sdk.MinDec(k.GetPIDIntegral(ctx).Add(err), sdk.OneDec()), sdk.OneDec().Neg())
+	derivative := err.Sub(k.GetPIDPreviousError(ctx))
+	k.SetPIDState(ctx, integral, err)
+
+	adjustment := params.PidKp.Mul(err).Add(params.PidKi.Mul(integral)).Add(params.PidKd.Mul(derivative))
+	factor := sdk.MaxDec(sdk.OneDec().Add(adjustment), sdk.ZeroDec())
+
+	baseFee := sdk.NewDecFromBigInt(parentBaseFee).Mul(factor).TruncateInt().BigInt()
+	if baseFee.Sign() == 0 {
+		// a zero base fee could never be increased again
+		baseFee = big.NewInt(1)
+	}
+
+	return boundBaseFee(params, baseFee)
+}
+
+// GetPIDIntegral returns the integral term of the PID base fee calculator.
+func (k Keeper) GetPIDIntegral(ctx sdk.Context) sdk.Dec {
+	return k.getDec(ctx, types.KeyPrefixPIDIntegral)
+}
+
+// GetPIDPreviousError returns the error of the previous PID base fee calculation.
+func (k Keeper) GetPIDPreviousError(ctx sdk.Context) sdk.Dec {
+	return k.getDec(ctx, types.KeyPrefixPIDPreviousError)
+}
+
+// SetPIDState stores the integral term and the error of the PID base fee calculator.
+func (k Keeper) SetPIDState(ctx sdk.Context, integral, err sdk.Dec) {
+	k.setDec(ctx, types.KeyPrefixPIDIntegral, integral)
+	k.setDec(ctx, types.KeyPrefixPIDPreviousError, err)
+}
+
+func (k Keeper) getDec(ctx sdk.Context, key []byte) sdk.Dec {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if len(bz) == 0 {
+		return sdk.ZeroDec()
+	}
+
+	var value sdk.Dec
+	if err := value.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return value
+}
+
+func (k Keeper) setDec(ctx sdk.Context, key []byte, value sdk.Dec) {
+	bz, err := value.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
+
+// boundBaseFee bounds the base fee by MinBaseFee (or the global min gas price if higher) and
+// MaxBaseFee if set.
+func boundBaseFee(params types.Params, baseFee *big.Int) *big.Int {
+	if !params.MinBaseFee.IsNil() && baseFee.Cmp(params.MinBaseFee.BigInt()) < 0 {
+		baseFee = params.MinBaseFee.BigInt()
+	}
+
+	// transactions below the min gas price don't even reach the mempool
+	if minGasPrice := params.MinGasPrice.TruncateInt().BigInt(); baseFee.Cmp(minGasPrice) < 0 {
+		baseFee = minGasPrice
+	}
+
+	if !params.MaxBaseFee.IsNil() && params.MaxBaseFee.IsPositive() && baseFee.Cmp(params.MaxBaseFee.BigInt()) > 0 {
+		baseFee = params.MaxBaseFee.BigInt()
+	}
+
+	return baseFee
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/evmos/ethermint/x/feemarket/keeper"
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+type constantBaseFeeCalculator struct{}
+
+func (constantBaseFeeCalculator) CalculateBaseFee(sdk.Context, keeper.Keeper, types.Params, *big.Int) *big.Int {
+	return big.NewInt(42)
+}
+
+func (suite *KeeperTestSuite) TestBaseFeeCalculators() {
+	testCases := []struct {
+		name                 string
+		malleate             func(params *types.Params)
+		parentBlockGasWanted uint64
+		parentBlockGasUsed   uint64
+		expFee               *big.Int
+	}{
+		{
+			"eip1559 - based on the gas wanted",
+			func(params *types.Params) {},
+			25, 100,
+			big.NewInt(937500000),
+		},
+		{
+			"eip1559 - unset calculator defaults to eip1559",
+			func(params *types.Params) { params.BaseFeeCalculator = "" },
+			100, 0,
+			big.NewInt(1125000000),
+		},
+		{
+			"eip1559 - unknown calculator falls back to eip1559",
+			func(params *types.Params) { params.BaseFeeCalculator = "unknown" },
+			100, 0,
+			big.NewInt(1125000000),
+		},
+		{
+			"gas used - parent block used more gas than its target",
+			func(params *types.Params) { params.BaseFeeCalculator = types.BaseFeeCalculatorGasUsed },
+			25, 100,
+			big.NewInt(1125000000),
+		},
+		{
+			"gas used - parent block used less gas than its target",
+			func(params *types.Params) { params.BaseFeeCalculator = types.BaseFeeCalculatorGasUsed },
+			100, 25,
+			big.NewInt(937500000),
+		},
+		{
+			"aimd - additive increase",
+			func(params *types.Params) { params.BaseFeeCalculator = types.BaseFeeCalculatorAIMD },
+			100, 100,
+			big.NewInt(1100000000),
+		},
+		{
+			"aimd - multiplicative decrease",
+			func(params *types.Params) { params.BaseFeeCalculator = types.BaseFeeCalculatorAIMD },
+			50, 50,
+			big.NewInt(875000000),
+		},
+		{
+			"aimd - bounded by the min base fee",
+			func(params *types.Params) {
+				params.BaseFeeCalculator = types.BaseFeeCalculatorAIMD
+				params.MinBaseFee = sdkmath.NewInt(900000000)
+			},
+			25, 25,
+			big.NewInt(900000000),
+		},
+		{
+			"aimd - bounded by the min gas price",
+			func(params *types.Params) {
+				params.BaseFeeCalculator = types.BaseFeeCalculatorAIMD
+				params.MinBaseFee = sdkmath.NewInt(900000000)
+				params.MinGasPrice = sdk.NewDec(950000000)
+			},
+			25, 25,
+			big.NewInt(950000000),
+		},
+		{
+			"aimd - bounded by the max base fee",
+			func(params *types.Params) {
+				params.BaseFeeCalculator = types.BaseFeeCalculatorAIMD
+				params.MaxBaseFee = sdkmath.NewInt(1050000000)
+			},
+			100, 100,
+			big.NewInt(1050000000),
+		},
+		{
+			"pid - parent block above the target utilization",
+			func(params *types.Params) { params.BaseFeeCalculator = types.BaseFeeCalculatorPID },
+			100, 100,
+			big.NewInt(1150000000),
+		},
+		{
+			"pid - parent block at the target utilization",
+			func(params *types.Params) { params.BaseFeeCalculator = types.BaseFeeCalculatorPID },
+			50, 50,
+			big.NewInt(1000000000),
+		},
+		{
+			"pid - parent block below the target utilization",
+			func(params *types.Params) { params.BaseFeeCalculator = types.BaseFeeCalculatorPID },
+			0, 0,
+			big.NewInt(850000000),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			tc.malleate(&params)
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+			suite.ctx = suite.ctx.WithBlockHeight(1)
+			suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, tc.parentBlockGasWanted)
+			suite.app.FeeMarketKeeper.SetBlockGasUsed(suite.ctx, tc.parentBlockGasUsed)
+			suite.ctx = suite.ctx.WithConsensusParams(&abci.ConsensusParams{
+				Block: &abci.BlockParams{MaxGas: 100, MaxBytes: 10},
+			})
+
+			suite.Require().Equal(tc.expFee, suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPIDBaseFeeCalculatorState() {
+	suite.SetupTest()
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.BaseFeeCalculator = types.BaseFeeCalculatorPID
+	params.PidKd = sdk.NewDecWithPrec(1, 1)
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	suite.ctx = suite.ctx.WithBlockHeight(1).WithConsensusParams(&abci.ConsensusParams{
+		Block: &abci.BlockParams{MaxGas: 100, MaxBytes: 10},
+	})
+
+	// full block: error 0.5, integral 0.5, derivative 0.5
+	suite.app.FeeMarketKeeper.SetBlockGasWanted(suite.ctx, 100)
+	suite.Require().Equal(big.NewInt(1200000000), suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx))
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), suite.app.FeeMarketKeeper.GetPIDIntegral(suite.ctx))
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), suite.app.FeeMarketKeeper.GetPIDPreviousError(suite.ctx))
+
+	// second full block: error 0.5, integral 1, derivative 0
+	suite.Require().Equal(big.NewInt(1175000000), suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx))
+
+	// third full block: the integral is limited to 1
+	suite.Require().Equal(big.NewInt(1175000000), suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx))
+	suite.Require().Equal(sdk.OneDec(), suite.app.FeeMarketKeeper.GetPIDIntegral(suite.ctx))
+}
+
+func (suite *KeeperTestSuite) TestRegisterBaseFeeCalculator() {
+	suite.SetupTest()
+
+	suite.Require().False(suite.app.FeeMarketKeeper.HasBaseFeeCalculator("constant"))
+	suite.app.FeeMarketKeeper.RegisterBaseFeeCalculator("constant", constantBaseFeeCalculator{})
+	suite.Require().True(suite.app.FeeMarketKeeper.HasBaseFeeCalculator("constant"))
+	suite.Require().Panics(func() {
+		suite.app.FeeMarketKeeper.RegisterBaseFeeCalculator(types.BaseFeeCalculatorEIP1559, constantBaseFeeCalculator{})
+	})
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.BaseFeeCalculator = "constant"
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	suite.ctx = suite.ctx.WithBlockHeight(1)
+	suite.Require().Equal(big.NewInt(42), suite.app.FeeMarketKeeper.CalculateBaseFee(suite.ctx))
+}
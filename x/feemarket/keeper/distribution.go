@@ -0,0 +1,124 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"github.com/armon/go-metrics"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// DistributeBaseFees splits the base fee portion of the fees collected in the current block
+// between burning, the community pool and the block proposer according to the module
+// parameters. The remainder is left in the fee collector and distributed as regular fees.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) DistributeBaseFees(ctx sdk.Context) error {
+	burnRatio, communityPoolRatio, proposerRatio := k.GetParams(ctx).BaseFeeRatios()
+	if burnRatio.IsZero() && communityPoolRatio.IsZero() && proposerRatio.IsZero() {
+		return nil
+	}
+
+	// the base fees can't exceed the fees held by the fee collector
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	baseFees := sdk.Coins{}
+	for _, fee := range k.GetTransientBaseFees(ctx) {
+		balance := k.bankKeeper.GetBalance(ctx, feeCollector, fee.Denom)
+		baseFees = baseFees.Add(sdk.NewCoin(fee.Denom, sdkmath.MinInt(fee.Amount, balance.Amount)))
+	}
+
+	if baseFees.IsZero() {
+		return nil
+	}
+
+	burned := feesPortion(baseFees, burnRatio)
+	if !burned.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burned); err != nil {
+			return errorsmod.Wrap(err, "failed to collect the base fees to burn")
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			return errorsmod.Wrap(err, "failed to burn the base fees")
+		}
+	}
+
+	communityPool := feesPortion(baseFees, communityPoolRatio)
+	if !communityPool.IsZero() {
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, feeCollector); err != nil {
+			return errorsmod.Wrap(err, "failed to fund the community pool with the base fees")
+		}
+	}
+
+	// the proposer portion is left in the fee collector if the proposer is unknown
+	proposerFees := sdk.Coins{}
+	proposer := k.stakingKeeper.ValidatorByConsAddr(ctx, ctx.BlockHeader().ProposerAddress)
+	if proposer != nil {
+		proposerFees = feesPortion(baseFees, proposerRatio)
+	}
+
+	if !proposerFees.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, distrtypes.ModuleName, proposerFees); err != nil {
+			return errorsmod.Wrap(err, "failed to allocate the base fees to the block proposer")
+		}
+		k.distrKeeper.AllocateTokensToValidator(ctx, proposer, sdk.NewDecCoinsFromCoins(proposerFees...))
+	}
+
+	defer func() {
+		for _, coin := range burned {
+			// the amount can exceed an int64, so it's converted from its big.Int value
+			amount, _ := new(big.Float).SetInt(coin.Amount.BigInt()).Float32()
+			telemetry.IncrCounterWithLabels(
+				[]string{"feemarket", "base_fee", "burned"},
+				amount,
+				[]metrics.Label{telemetry.NewLabel("denom", coin.Denom)},
+			)
+		}
+	}()
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyBaseFee, baseFees.String()),
+		sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+		sdk.NewAttribute(types.AttributeKeyCommunityPool, communityPool.String()),
+		sdk.NewAttribute(types.AttributeKeyProposerFees, proposerFees.String()),
+	}
+	if proposer != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyProposer, proposer.GetOperator().String()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeBaseFeeDistribution, attrs...))
+
+	k.Logger(ctx).Debug(
+		"distributed base fees",
+		"height", ctx.BlockHeight(),
+		"burned", burned.String(),
+		"community-pool", communityPool.String(),
+		"proposer", proposerFees.String(),
+	)
+
+	return nil
+}
+
+// feesPortion returns the given fraction of the fees, truncated to integer amounts.
+func feesPortion(fees sdk.Coins, ratio sdk.Dec) sdk.Coins {
+	portion, _ := sdk.NewDecCoinsFromCoins(fees...).MulDecTruncate(ratio).TruncateDecimal()
+	return portion
+}
@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"math"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/tendermint/tendermint/abci/types"
+
+	"github.com/evmos/ethermint/testutil"
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestDistributeBaseFees() {
+	testCases := []struct {
+		name             string
+		burnRatio        sdk.Dec
+		communityRatio   sdk.Dec
+		proposerRatio    sdk.Dec
+		collected        int64
+		baseFees         int64
+		expBurned        int64
+		expCommunityPool int64
+		expProposer      int64
+	}{
+		{
+			"no ratios, base fees left in the fee collector",
+			sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(),
+			1000, 800,
+			0, 0, 0,
+		},
+		{
+			"split between burn, community pool and proposer",
+			sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1),
+			1000, 800,
+			400, 160, 80,
+		},
+		{
+			"burn all the base fees",
+			sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec(),
+			1000, 800,
+			800, 0, 0,
+		},
+		{
+			"base fees capped by the fee collector balance",
+			sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec(),
+			300, 800,
+			300, 0, 0,
+		},
+		{
+			"no base fees",
+			sdk.OneDec(), sdk.ZeroDec(), sdk.ZeroDec(),
+			1000, 0,
+			0, 0, 0,
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.BaseFeeBurnRatio = tc.burnRatio
+			params.BaseFeeCommunityPoolRatio = tc.communityRatio
+			params.BaseFeeProposerRatio = tc.proposerRatio
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+			collected := sdk.NewCoins(sdk.NewInt64Coin(denom, tc.collected))
+			suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, authtypes.FeeCollectorName, collected))
+			suite.app.FeeMarketKeeper.AddTransientBaseFees(suite.ctx, sdk.NewCoins(sdk.NewInt64Coin(denom, tc.baseFees)))
+
+			supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount
+			communityPoolBefore := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom)
+			valAddr := sdk.ValAddress(suite.address.Bytes())
+			rewardsBefore := suite.app.DistrKeeper.GetValidatorOutstandingRewards(suite.ctx, valAddr).Rewards.AmountOf(denom)
+
+			suite.app.FeeMarketKeeper.EndBlock(suite.ctx, types.RequestEndBlock{Height: 1})
+
+			burned := supplyBefore.Sub(suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+			suite.Require().Equal(tc.expBurned, burned.Int64())
+
+			communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(denom).Sub(communityPoolBefore)
+			suite.Require().Equal(sdk.NewDec(tc.expCommunityPool), communityPool)
+
+			rewards := suite.app.DistrKeeper.GetValidatorOutstandingRewards(suite.ctx, valAddr).Rewards.AmountOf(denom).Sub(rewardsBefore)
+			suite.Require().Equal(sdk.NewDec(tc.expProposer), rewards)
+
+			expCollected := tc.collected - tc.expBurned - tc.expCommunityPool - tc.expProposer
+			suite.Require().Equal(expCollected, suite.app.BankKeeper.GetBalance(suite.ctx, feeCollector, denom).Amount.Int64())
+
+			events := suite.ctx.EventManager().Events()
+			found := false
+			for _, event := range events {
+				if event.Type == feemarkettypes.EventTypeBaseFeeDistribution {
+					found = true
+				}
+			}
+			suite.Require().Equal(tc.expBurned+tc.expCommunityPool+tc.expProposer > 0, found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeBaseFeesAboveMaxInt64() {
+	suite.SetupTest() // reset
+	denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.BaseFeeBurnRatio = sdk.OneDec()
+	params.BaseFeeCommunityPoolRatio = sdk.ZeroDec()
+	params.BaseFeeProposerRatio = sdk.ZeroDec()
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	amount := sdkmath.NewInt(math.MaxInt64).MulRaw(10)
+	fees := sdk.NewCoins(sdk.NewCoin(denom, amount))
+	suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, authtypes.FeeCollectorName, fees))
+	suite.app.FeeMarketKeeper.AddTransientBaseFees(suite.ctx, fees)
+
+	supplyBefore := suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount
+
+	suite.Require().NotPanics(func() {
+		suite.Require().NoError(suite.app.FeeMarketKeeper.DistributeBaseFees(suite.ctx))
+	})
+
+	burned := supplyBefore.Sub(suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+	suite.Require().Equal(amount, burned)
+}
@@ -22,12 +22,13 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
 )
 
-// CalculateBaseFee calculates the base fee for the current block. This is only calculated once per
-// block during BeginBlock. If the NoBaseFee parameter is enabled or below activation height, this function returns nil.
-// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
-// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+// CalculateBaseFee calculates the base fee for the current block with the base fee calculator selected
+// by the BaseFeeCalculator parameter. This is only calculated once per block during BeginBlock. If the
+// NoBaseFee parameter is enabled or below activation height, this function returns nil.
 func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	params := k.GetParams(ctx)
 
@@ -36,8 +37,6 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 		return nil
 	}
 
-	consParams := ctx.ConsensusParams()
-
 	// If the current block is the first EIP-1559 block, return the base fee
 	// defined in the parameters (DefaultBaseFee if it hasn't been changed by
 	// governance).
@@ -45,7 +44,7 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 		return params.BaseFee.BigInt()
 	}
 
-	// get the block gas used and the base fee values for the parent block.
+	// get the base fee value for the parent block.
 	// NOTE: this is not the parent's base fee but the current block's base fee,
 	// as it is retrieved from the transient store, which is committed to the
 	// persistent KVStore after EndBlock (ABCI Commit).
@@ -54,14 +53,31 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 		return nil
 	}
 
-	parentGasUsed := k.GetBlockGasWanted(ctx)
+	name := params.BaseFeeCalculatorName()
+	calculator, found := k.calculators[name]
+	if !found {
+		// the parameters validation can't check the calculators registered by the application
+		k.Logger(ctx).Error("unknown base fee calculator, falling back to EIP-1559", "calculator", name)
+		calculator = EIP1559BaseFeeCalculator{}
+	}
 
-	gasLimit := new(big.Int).SetUint64(math.MaxUint64)
+	return calculator.CalculateBaseFee(ctx, k, params, parentBaseFee)
+}
 
-	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
-	if consParams != nil && consParams.Block.MaxGas > -1 {
-		gasLimit = big.NewInt(consParams.Block.MaxGas)
-	}
+// EIP1559BaseFeeCalculator is the default BaseFeeCalculator, which adjusts the base fee following
+// EIP-1559 based on the gas wanted by the parent block.
+// NOTE: This code is inspired from the go-ethereum EIP1559 implementation and adapted to Cosmos SDK-based
+// chains. For the canonical code refer to: https://github.com/ethereum/go-ethereum/blob/master/consensus/misc/eip1559.go
+type EIP1559BaseFeeCalculator struct{}
+
+// CalculateBaseFee implements BaseFeeCalculator
+func (EIP1559BaseFeeCalculator) CalculateBaseFee(ctx sdk.Context, k Keeper, params types.Params, parentBaseFee *big.Int) *big.Int {
+	return calculateEIP1559BaseFee(ctx, params, parentBaseFee, k.GetBlockGasWanted(ctx))
+}
+
+// calculateEIP1559BaseFee applies the EIP-1559 base fee adjustment for the given parent block gas.
+func calculateEIP1559BaseFee(ctx sdk.Context, params types.Params, parentBaseFee *big.Int, parentGasUsed uint64) *big.Int {
+	gasLimit := blockGasLimit(ctx)
 
 	// CONTRACT: ElasticityMultiplier cannot be 0 as it's checked in the params
 	// validation
@@ -105,3 +121,15 @@ func (k Keeper) CalculateBaseFee(ctx sdk.Context) *big.Int {
 	minGasPrice := params.MinGasPrice.TruncateInt().BigInt()
 	return math.BigMax(x.Sub(parentBaseFee, baseFeeDelta), minGasPrice)
 }
+
+// blockGasLimit returns the block gas limit from the consensus parameters.
+func blockGasLimit(ctx sdk.Context) *big.Int {
+	consParams := ctx.ConsensusParams()
+
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited
+	if consParams != nil && consParams.Block.MaxGas > -1 {
+		return big.NewInt(consParams.Block.MaxGas)
+	}
+
+	return new(big.Int).SetUint64(math.MaxUint64)
+}
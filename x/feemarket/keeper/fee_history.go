@@ -0,0 +1,116 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// SetBlockFeeHistory stores the fee history of a block, indexed by its height.
+func (k Keeper) SetBlockFeeHistory(ctx sdk.Context, history types.BlockFeeHistory) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeHistory)
+	store.Set(sdk.Uint64ToBigEndian(uint64(history.Height)), k.cdc.MustMarshal(&history))
+}
+
+// GetBlockFeeHistory returns the fee history stored for the block at the given height.
+func (k Keeper) GetBlockFeeHistory(ctx sdk.Context, height int64) (types.BlockFeeHistory, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeHistory)
+	bz := store.Get(sdk.Uint64ToBigEndian(uint64(height)))
+	if len(bz) == 0 {
+		return types.BlockFeeHistory{}, false
+	}
+
+	var history types.BlockFeeHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	return history, true
+}
+
+// GetFeeHistory returns the fee history stored for the blocks between the start and end
+// heights (both included), in ascending height order.
+func (k Keeper) GetFeeHistory(ctx sdk.Context, startHeight, endHeight int64) []types.BlockFeeHistory {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeHistory)
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(uint64(startHeight)), sdk.Uint64ToBigEndian(uint64(endHeight)+1))
+	defer iterator.Close()
+
+	history := []types.BlockFeeHistory{}
+	for ; iterator.Valid(); iterator.Next() {
+		var blockHistory types.BlockFeeHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &blockHistory)
+		history = append(history, blockHistory)
+	}
+
+	return history
+}
+
+// PruneFeeHistory deletes the fee history stored for the blocks below the given height.
+func (k Keeper) PruneFeeHistory(ctx sdk.Context, height int64) {
+	if height <= 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixFeeHistory)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(height)))
+	defer iterator.Close()
+
+	// collect the keys first as the store can't be written while iterating
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RecordFeeHistory stores the fee history of the current block and prunes the blocks that
+// fall outside of the FeeHistoryBlocks window.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) RecordFeeHistory(ctx sdk.Context, gasWanted, gasUsed uint64) {
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+
+	// prune everything if the fee history was disabled
+	if params.FeeHistoryBlocks == 0 {
+		k.PruneFeeHistory(ctx, height+1)
+		return
+	}
+
+	history := types.BlockFeeHistory{
+		Height:    height,
+		GasUsed:   gasUsed,
+		GasWanted: gasWanted,
+		GasLimit:  -1,
+	}
+
+	if params.IsBaseFeeEnabled(height) {
+		if baseFee := k.GetBaseFee(ctx); baseFee != nil {
+			aux := sdkmath.NewIntFromBigInt(baseFee)
+			history.BaseFee = &aux
+		}
+	}
+
+	if consParams := ctx.ConsensusParams(); consParams != nil && consParams.Block != nil {
+		history.GasLimit = consParams.Block.MaxGas
+	}
+
+	k.SetBlockFeeHistory(ctx, history)
+	k.PruneFeeHistory(ctx, height-int64(params.FeeHistoryBlocks)+1)
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestRecordFeeHistory() {
+	testCases := []struct {
+		name       string
+		blocks     uint32
+		commits    int
+		expHeights []int64 // offsets from the initial height
+	}{
+		{"fee history disabled", 0, 3, []int64{}},
+		{"fee history of every block kept", 5, 3, []int64{0, 1, 2}},
+		{"oldest blocks pruned", 2, 3, []int64{1, 2}},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			suite.Commit()    // start a block through BeginBlock
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.FeeHistoryBlocks = tc.blocks
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+			initialHeight := suite.ctx.BlockHeight()
+			for i := 0; i < tc.commits; i++ {
+				suite.Commit()
+			}
+
+			history := suite.app.FeeMarketKeeper.GetFeeHistory(suite.ctx, initialHeight, suite.ctx.BlockHeight())
+			suite.Require().Len(history, len(tc.expHeights))
+			for i, offset := range tc.expHeights {
+				suite.Require().Equal(initialHeight+offset, history[i].Height)
+				suite.Require().NotNil(history[i].BaseFee)
+			}
+
+			res, err := suite.queryClient.FeeHistory(suite.ctx.Context(), &types.QueryFeeHistoryRequest{
+				StartHeight: initialHeight,
+				EndHeight:   suite.ctx.BlockHeight(),
+			})
+			suite.Require().NoError(err)
+			suite.Require().ElementsMatch(history, res.History)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPruneFeeHistoryAfterDisabling() {
+	suite.SetupTest()
+
+	suite.Commit()
+	suite.Commit()
+	suite.Require().NotEmpty(suite.app.FeeMarketKeeper.GetFeeHistory(suite.ctx, 0, suite.ctx.BlockHeight()))
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.FeeHistoryBlocks = 0
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+	suite.Commit()
+
+	suite.Require().Empty(suite.app.FeeMarketKeeper.GetFeeHistory(suite.ctx, 0, suite.ctx.BlockHeight()))
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeHistory() {
+	testCases := []struct {
+		name    string
+		req     *types.QueryFeeHistoryRequest
+		expPass bool
+	}{
+		{"fail - empty request", nil, false},
+		{"fail - negative start height", &types.QueryFeeHistoryRequest{StartHeight: -1, EndHeight: 1}, false},
+		{"fail - end height lower than start height", &types.QueryFeeHistoryRequest{StartHeight: 2, EndHeight: 1}, false},
+		{"fail - range too large", &types.QueryFeeHistoryRequest{StartHeight: 1, EndHeight: types.MaxFeeHistoryBlocks + 1}, false},
+		{"pass - single block", &types.QueryFeeHistoryRequest{StartHeight: 1, EndHeight: 1}, true},
+		{"pass - largest range", &types.QueryFeeHistoryRequest{StartHeight: 1, EndHeight: types.MaxFeeHistoryBlocks}, true},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.app.FeeMarketKeeper.FeeHistory(suite.ctx, tc.req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
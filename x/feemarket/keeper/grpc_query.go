@@ -20,6 +20,8 @@ import (
 
 	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/evmos/ethermint/x/feemarket/types"
 )
@@ -60,3 +62,40 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: int64(gas),
 	}, nil
 }
+
+// MinGasPrice implements the Query/MinGasPrice gRPC method
+func (k Keeper) MinGasPrice(c context.Context, _ *types.QueryMinGasPriceRequest) (*types.QueryMinGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QueryMinGasPriceResponse{
+		MinGasPrice: k.GetParams(ctx).MinGasPrice,
+	}
+
+	if tip, samples, found := k.GetMinGasPriceOracleTip(ctx); found {
+		res.OracleTip = &tip
+		res.Samples = uint32(samples)
+	}
+
+	return res, nil
+}
+
+// FeeHistory implements the Query/FeeHistory gRPC method
+func (k Keeper) FeeHistory(c context.Context, req *types.QueryFeeHistoryRequest) (*types.QueryFeeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.StartHeight < 0 || req.EndHeight < req.StartHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid height range [%d, %d]", req.StartHeight, req.EndHeight)
+	}
+
+	if req.EndHeight-req.StartHeight >= types.MaxFeeHistoryBlocks {
+		return nil, status.Errorf(codes.InvalidArgument, "height range cannot exceed %d blocks", types.MaxFeeHistoryBlocks)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeHistoryResponse{
+		History: k.GetFeeHistory(ctx, req.StartHeight, req.EndHeight),
+	}, nil
+}
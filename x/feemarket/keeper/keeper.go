@@ -18,7 +18,9 @@ package keeper
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -39,13 +41,21 @@ type Keeper struct {
 	transientKey storetypes.StoreKey
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority sdk.AccAddress
+	// keepers used to distribute the base fee portion of the block fees
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+	stakingKeeper types.StakingKeeper
+	// base fee calculators selectable with the BaseFeeCalculator parameter
+	calculators map[string]BaseFeeCalculator
 	// Legacy subspace
 	ss paramstypes.Subspace
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
-	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey, ss paramstypes.Subspace,
+	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper, distrKeeper types.DistributionKeeper, stakingKeeper types.StakingKeeper,
+	ss paramstypes.Subspace,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -53,11 +63,15 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		authority:    authority,
-		transientKey: transientKey,
-		ss:           ss,
+		cdc:           cdc,
+		storeKey:      storeKey,
+		authority:     authority,
+		transientKey:  transientKey,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		stakingKeeper: stakingKeeper,
+		calculators:   defaultBaseFeeCalculators(),
+		ss:            ss,
 	}
 }
 
@@ -91,6 +105,21 @@ func (k Keeper) GetBlockGasWanted(ctx sdk.Context) uint64 {
 }
 
 // GetTransientGasWanted returns the gas wanted in the current block from transient store.
+func (k Keeper) SetBlockGasUsed(ctx sdk.Context, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixBlockGasUsed, sdk.Uint64ToBigEndian(gas))
+}
+
+func (k Keeper) GetBlockGasUsed(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixBlockGasUsed)
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
 func (k Keeper) GetTransientGasWanted(ctx sdk.Context) uint64 {
 	store := ctx.TransientStore(k.transientKey)
 	bz := store.Get(types.KeyPrefixTransientBlockGasWanted)
@@ -114,6 +143,47 @@ func (k Keeper) AddTransientGasWanted(ctx sdk.Context, gasWanted uint64) (uint64
 	return result, nil
 }
 
+// GetTransientBaseFees returns the base fee portion of the fees paid in the current block
+// from the transient store.
+func (k Keeper) GetTransientBaseFees(ctx sdk.Context) sdk.Coins {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBaseFees)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	fees := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		fees = fees.Add(sdk.NewCoin(string(iterator.Key()), amount))
+	}
+
+	return fees
+}
+
+// AddTransientBaseFees adds the base fee portion of the fees paid by a transaction to the
+// cumulative amount of the block in the transient store.
+func (k Keeper) AddTransientBaseFees(ctx sdk.Context, fees sdk.Coins) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientBaseFees)
+	for _, fee := range fees {
+		amount := fee.Amount
+		if bz := store.Get([]byte(fee.Denom)); len(bz) > 0 {
+			var previous sdkmath.Int
+			if err := previous.Unmarshal(bz); err != nil {
+				panic(err)
+			}
+			amount = amount.Add(previous)
+		}
+
+		bz, err := amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set([]byte(fee.Denom), bz)
+	}
+}
+
 // GetBaseFeeV1 get the base fee from v1 version of states.
 // return nil if base fee is not enabled
 // TODO: Figure out if this will be deleted ?
@@ -25,6 +25,18 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 			},
 			expectErr: false,
 		},
+		{
+			name: "fail - unknown base fee calculator",
+			request: &types.MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.BaseFeeCalculator = "unknown"
+					return params
+				}(),
+			},
+			expectErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,159 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// AddTransientTip records the effective tip paid per unit of gas by an Ethereum
+// transaction of the current block. It's a no-op if the min gas price oracle is
+// disabled.
+func (k Keeper) AddTransientTip(ctx sdk.Context, tip *big.Int) {
+	if tip == nil || tip.Sign() < 0 || !k.GetParams(ctx).IsMinGasPriceOracleEnabled() {
+		return
+	}
+
+	store := ctx.TransientStore(k.transientKey)
+	count := uint64(0)
+	if bz := store.Get(types.KeyPrefixTransientTipCount); len(bz) > 0 {
+		count = sdk.BigEndianToUint64(bz)
+	}
+
+	bz, err := sdkmath.NewIntFromBigInt(tip).Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	prefix.NewStore(store, types.KeyPrefixTransientTips).Set(sdk.Uint64ToBigEndian(count), bz)
+	store.Set(types.KeyPrefixTransientTipCount, sdk.Uint64ToBigEndian(count+1))
+}
+
+// GetTransientTips returns the effective tips recorded in the current block.
+func (k Keeper) GetTransientTips(ctx sdk.Context) []sdkmath.Int {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixTransientTips)
+	return unmarshalInts(store.Iterator(nil, nil))
+}
+
+// SetMinGasPriceOracleSample records the tip percentile of the current block in the
+// min gas price oracle ring buffer, overwriting the oldest sample once it's full.
+func (k Keeper) SetMinGasPriceOracleSample(ctx sdk.Context, tip sdkmath.Int, blocks uint32) {
+	store := ctx.KVStore(k.storeKey)
+	count := k.getMinGasPriceOracleCount(ctx)
+
+	bz, err := tip.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	slot := count % uint64(blocks)
+	prefix.NewStore(store, types.KeyPrefixMinGasPriceOracleSamples).Set(sdk.Uint64ToBigEndian(slot), bz)
+	store.Set(types.KeyPrefixMinGasPriceOracleCount, sdk.Uint64ToBigEndian(count+1))
+}
+
+// GetMinGasPriceOracleSamples returns the tip percentiles of the recent blocks tracked
+// by the min gas price oracle, in no particular order.
+func (k Keeper) GetMinGasPriceOracleSamples(ctx sdk.Context, blocks uint32) []sdkmath.Int {
+	size := k.getMinGasPriceOracleCount(ctx)
+	if size > uint64(blocks) {
+		size = uint64(blocks)
+	}
+
+	// samples stored in slots beyond the current buffer size (i.e before the number of
+	// tracked blocks was lowered) are ignored
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixMinGasPriceOracleSamples)
+	return unmarshalInts(store.Iterator(nil, sdk.Uint64ToBigEndian(size)))
+}
+
+// GetMinGasPriceOracleTip returns the configured percentile of the effective tips paid
+// over the recent blocks, along with the number of blocks it was computed from. It
+// returns false if the oracle is disabled or hasn't recorded any block yet.
+func (k Keeper) GetMinGasPriceOracleTip(ctx sdk.Context) (tip sdkmath.Int, samples int, found bool) {
+	params := k.GetParams(ctx)
+	if !params.IsMinGasPriceOracleEnabled() {
+		return sdkmath.Int{}, 0, false
+	}
+
+	tips := k.GetMinGasPriceOracleSamples(ctx, params.MinGasPriceOracleBlocks)
+	if len(tips) == 0 {
+		return sdkmath.Int{}, 0, false
+	}
+
+	return percentile(tips, params.MinGasPriceOraclePercentile), len(tips), true
+}
+
+// UpdateMinGasPriceOracle records the tip percentile of the current block in the
+// min gas price oracle. Blocks without Ethereum transactions are not recorded.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) UpdateMinGasPriceOracle(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.IsMinGasPriceOracleEnabled() {
+		return
+	}
+
+	tips := k.GetTransientTips(ctx)
+	if len(tips) == 0 {
+		return
+	}
+
+	tip := percentile(tips, params.MinGasPriceOraclePercentile)
+	k.SetMinGasPriceOracleSample(ctx, tip, params.MinGasPriceOracleBlocks)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMinGasPriceOracle,
+		sdk.NewAttribute(types.AttributeKeyTip, tip.String()),
+	))
+}
+
+func (k Keeper) getMinGasPriceOracleCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixMinGasPriceOracleCount)
+	if len(bz) == 0 {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// unmarshalInts decodes the Int values of the given iterator and closes it.
+func unmarshalInts(iterator sdk.Iterator) []sdkmath.Int {
+	defer iterator.Close()
+
+	values := []sdkmath.Int{}
+	for ; iterator.Valid(); iterator.Next() {
+		var value sdkmath.Int
+		if err := value.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		values = append(values, value)
+	}
+
+	return values
+}
+
+// percentile returns the nearest-rank percentile p (0-100) of the given values.
+func percentile(values []sdkmath.Int, p uint32) sdkmath.Int {
+	sorted := make([]sdkmath.Int, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].LT(sorted[j]) })
+
+	return sorted[(len(sorted)-1)*int(p)/types.MaxMinGasPriceOraclePercentile]
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestMinGasPriceOracle() {
+	testCases := []struct {
+		name           string
+		blocks         uint32
+		blockTips      [][]int64
+		minGasPrice    sdk.Dec
+		expFound       bool
+		expTip         int64
+		expSamples     uint32
+		expMinGasPrice sdk.Dec
+	}{
+		{
+			"oracle disabled",
+			0,
+			[][]int64{{1, 2, 3}},
+			sdk.NewDec(7),
+			false, 0, 0,
+			sdk.NewDec(7),
+		},
+		{
+			"no samples recorded yet",
+			3,
+			[][]int64{{}},
+			sdk.NewDec(7),
+			false, 0, 0,
+			sdk.NewDec(7),
+		},
+		{
+			"percentile of the block percentiles, empty blocks skipped",
+			3,
+			[][]int64{{1, 2, 3}, {10}, {}, {5, 7}},
+			sdk.ZeroDec(),
+			true, 5, 3,
+			sdk.ZeroDec(),
+		},
+		{
+			"oldest sample overwritten, the oracle isn't enforced as min gas price",
+			3,
+			[][]int64{{1, 2, 3}, {10}, {5, 7}, {100}},
+			sdk.NewDec(7),
+			true, 10, 3,
+			sdk.NewDec(7),
+		},
+		{
+			"falling demand lowers the oracle tip",
+			3,
+			[][]int64{{100}, {100}, {100}, {10}, {10}, {10}},
+			sdk.ZeroDec(),
+			true, 10, 3,
+			sdk.ZeroDec(),
+		},
+		{
+			"min gas price param higher than the oracle tip",
+			3,
+			[][]int64{{1, 2, 3}, {10}, {5, 7}},
+			sdk.NewDec(20),
+			true, 5, 3,
+			sdk.NewDec(20),
+		},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.MinGasPrice = tc.minGasPrice
+			params.MinGasPriceOracleBlocks = tc.blocks
+			params.MinGasPriceOraclePercentile = 50
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+			for _, tips := range tc.blockTips {
+				for _, tip := range tips {
+					suite.app.FeeMarketKeeper.AddTransientTip(suite.ctx, big.NewInt(tip))
+				}
+				suite.Commit()
+			}
+
+			tip, samples, found := suite.app.FeeMarketKeeper.GetMinGasPriceOracleTip(suite.ctx)
+			suite.Require().Equal(tc.expFound, found)
+
+			res, err := suite.queryClient.MinGasPrice(suite.ctx.Context(), &types.QueryMinGasPriceRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expMinGasPrice, res.MinGasPrice)
+			suite.Require().Equal(tc.expSamples, res.Samples)
+
+			if tc.expFound {
+				suite.Require().Equal(tc.expTip, tip.Int64())
+				suite.Require().Equal(int(tc.expSamples), samples)
+				suite.Require().Equal(sdkmath.NewInt(tc.expTip), *res.OracleTip)
+			} else {
+				suite.Require().Nil(res.OracleTip)
+			}
+		})
+	}
+}
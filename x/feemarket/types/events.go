@@ -17,7 +17,14 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket           = "fee_market"
+	EventTypeBaseFeeDistribution = "base_fee_distribution"
+	EventTypeMinGasPriceOracle   = "min_gas_price_oracle"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee       = "base_fee"
+	AttributeKeyBurned        = "burned"
+	AttributeKeyCommunityPool = "community_pool"
+	AttributeKeyProposer      = "proposer"
+	AttributeKeyProposerFees  = "proposer_fees"
+	AttributeKeyTip           = "tip"
 )
@@ -43,6 +43,50 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// base_fee_burn_ratio defines the fraction of the base fee portion of the
+	// block fees that is burned at the end of the block
+	BaseFeeBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=base_fee_burn_ratio,json=baseFeeBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_burn_ratio"`
+	// base_fee_community_pool_ratio defines the fraction of the base fee portion
+	// of the block fees that is sent to the community pool at the end of the block
+	BaseFeeCommunityPoolRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=base_fee_community_pool_ratio,json=baseFeeCommunityPoolRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_community_pool_ratio"`
+	// base_fee_proposer_ratio defines the fraction of the base fee portion of the
+	// block fees that is allocated to the block proposer at the end of the block
+	BaseFeeProposerRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,11,opt,name=base_fee_proposer_ratio,json=baseFeeProposerRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_proposer_ratio"`
+	// min_gas_price_oracle_blocks defines the number of recent blocks whose
+	// effective tips are tracked by the min gas price oracle. Zero disables the
+	// oracle.
+	MinGasPriceOracleBlocks uint32 `protobuf:"varint,12,opt,name=min_gas_price_oracle_blocks,json=minGasPriceOracleBlocks,proto3" json:"min_gas_price_oracle_blocks,omitempty"`
+	// min_gas_price_oracle_percentile defines the percentile (0-100) of the
+	// effective tips of each block that is recorded by the min gas price oracle.
+	MinGasPriceOraclePercentile uint32 `protobuf:"varint,13,opt,name=min_gas_price_oracle_percentile,json=minGasPriceOraclePercentile,proto3" json:"min_gas_price_oracle_percentile,omitempty"`
+	// fee_history_blocks defines the number of recent blocks whose fee history is
+	// kept in the store. Zero disables the fee history.
+	FeeHistoryBlocks uint32 `protobuf:"varint,14,opt,name=fee_history_blocks,json=feeHistoryBlocks,proto3" json:"fee_history_blocks,omitempty"`
+	// base_fee_calculator defines the algorithm used to adjust the base fee
+	// between blocks: "eip1559" (default when empty), "gas_used", "aimd", "pid"
+	// or a calculator registered by the application.
+	BaseFeeCalculator string `protobuf:"bytes,15,opt,name=base_fee_calculator,json=baseFeeCalculator,proto3" json:"base_fee_calculator,omitempty"`
+	// min_base_fee defines the lower bound of the base fee computed by the aimd
+	// and pid calculators
+	MinBaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,16,opt,name=min_base_fee,json=minBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_base_fee"`
+	// max_base_fee defines the upper bound of the base fee computed by the aimd
+	// and pid calculators. Zero means unbounded.
+	MaxBaseFee github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,17,opt,name=max_base_fee,json=maxBaseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_base_fee"`
+	// aimd_additive_increase defines the amount added to the base fee by the aimd
+	// calculator when the parent block wanted more gas than its target
+	AimdAdditiveIncrease github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,18,opt,name=aimd_additive_increase,json=aimdAdditiveIncrease,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"aimd_additive_increase"`
+	// aimd_multiplicative_decrease defines the factor the base fee is multiplied
+	// by in the aimd calculator when the parent block didn't exceed its target
+	AimdMultiplicativeDecrease github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=aimd_multiplicative_decrease,json=aimdMultiplicativeDecrease,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"aimd_multiplicative_decrease"`
+	// pid_target_utilization defines the fraction of the block gas limit
+	// targeted by the pid calculator
+	PidTargetUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,20,opt,name=pid_target_utilization,json=pidTargetUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pid_target_utilization"`
+	// pid_kp defines the proportional gain of the pid calculator
+	PidKp github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,21,opt,name=pid_kp,json=pidKp,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pid_kp"`
+	// pid_ki defines the integral gain of the pid calculator
+	PidKi github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,22,opt,name=pid_ki,json=pidKi,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pid_ki"`
+	// pid_kd defines the derivative gain of the pid calculator
+	PidKd github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,23,opt,name=pid_kd,json=pidKd,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"pid_kd"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +150,113 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetMinGasPriceOracleBlocks() uint32 {
+	if m != nil {
+		return m.MinGasPriceOracleBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMinGasPriceOraclePercentile() uint32 {
+	if m != nil {
+		return m.MinGasPriceOraclePercentile
+	}
+	return 0
+}
+
+func (m *Params) GetFeeHistoryBlocks() uint32 {
+	if m != nil {
+		return m.FeeHistoryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetBaseFeeCalculator() string {
+	if m != nil {
+		return m.BaseFeeCalculator
+	}
+	return ""
+}
+
+// BlockFeeHistory defines the fee market values recorded at the end of a block
+type BlockFeeHistory struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// base_fee is the EIP1559 base fee of the block. It is empty if the base fee
+	// is not enabled.
+	BaseFee *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"base_fee,omitempty"`
+	// gas_used is the gas consumed by the block transactions
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_wanted is the block gas wanted used for the base fee calculation
+	GasWanted uint64 `protobuf:"varint,4,opt,name=gas_wanted,json=gasWanted,proto3" json:"gas_wanted,omitempty"`
+	// gas_limit is the block max gas consensus parameter, -1 if unlimited
+	GasLimit int64 `protobuf:"varint,5,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+}
+
+func (m *BlockFeeHistory) Reset()         { *m = BlockFeeHistory{} }
+func (m *BlockFeeHistory) String() string { return proto.CompactTextString(m) }
+func (*BlockFeeHistory) ProtoMessage()    {}
+func (*BlockFeeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *BlockFeeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFeeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFeeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFeeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFeeHistory.Merge(m, src)
+}
+func (m *BlockFeeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFeeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFeeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFeeHistory proto.InternalMessageInfo
+
+func (m *BlockFeeHistory) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasWanted() uint64 {
+	if m != nil {
+		return m.GasWanted
+	}
+	return 0
+}
+
+func (m *BlockFeeHistory) GetGasLimit() int64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*BlockFeeHistory)(nil), "ethermint.feemarket.v1.BlockFeeHistory")
 }
 
 func init() {
@@ -115,32 +264,57 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 388 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xc1, 0x8a, 0xdb, 0x30,
-	0x14, 0xb4, 0x9a, 0xdd, 0xac, 0x57, 0xdb, 0x40, 0x10, 0xdb, 0x62, 0x5a, 0xf0, 0x9a, 0x16, 0x16,
-	0x1f, 0x5a, 0x9b, 0x65, 0xcf, 0xbd, 0xa4, 0x21, 0x6d, 0x0a, 0x85, 0xe0, 0x63, 0x29, 0x08, 0xd9,
-	0x79, 0xb1, 0x45, 0x2c, 0xc9, 0x58, 0x4a, 0x68, 0xfe, 0xa2, 0x9f, 0x95, 0x63, 0x4e, 0xa5, 0xf4,
-	0x10, 0x4a, 0xf2, 0x23, 0x25, 0x76, 0x62, 0xe7, 0xda, 0x3d, 0x49, 0x7a, 0x33, 0x9a, 0x79, 0xd2,
-	0x1b, 0x7c, 0x0f, 0x26, 0x83, 0x52, 0x70, 0x69, 0xc2, 0x19, 0x80, 0x60, 0xe5, 0x1c, 0x4c, 0xb8,
-	0x7c, 0x68, 0x0f, 0x41, 0x51, 0x2a, 0xa3, 0xc8, 0xcb, 0x86, 0x17, 0xb4, 0xd0, 0xf2, 0xe1, 0xd5,
-	0x6d, 0xaa, 0x52, 0x55, 0x51, 0xc2, 0xc3, 0xae, 0x66, 0xbf, 0xf9, 0xd5, 0xc1, 0xdd, 0x09, 0x2b,
-	0x99, 0xd0, 0xc4, 0xc5, 0x37, 0x52, 0xd1, 0x98, 0x69, 0xa0, 0x33, 0x00, 0x07, 0x79, 0xc8, 0xb7,
-	0xa3, 0x6b, 0xa9, 0x06, 0x4c, 0xc3, 0x08, 0x80, 0x7c, 0xc0, 0xaf, 0x4f, 0x20, 0x4d, 0x32, 0x26,
-	0x53, 0xa0, 0x53, 0x90, 0x4a, 0x70, 0xc9, 0x8c, 0x2a, 0x9d, 0x67, 0x1e, 0xf2, 0x7b, 0x91, 0x13,
-	0xd7, 0xec, 0x8f, 0x15, 0x61, 0xd8, 0xe2, 0xe4, 0x11, 0xbf, 0x80, 0x9c, 0x69, 0xc3, 0x13, 0x6e,
-	0x56, 0x54, 0x2c, 0x72, 0xc3, 0x8b, 0x9c, 0x43, 0xe9, 0x74, 0xaa, 0x8b, 0xb7, 0x2d, 0xf8, 0xb5,
-	0xc1, 0xc8, 0x5b, 0xdc, 0x03, 0xc9, 0xe2, 0x1c, 0x68, 0x06, 0x3c, 0xcd, 0x8c, 0x73, 0xe9, 0x21,
-	0xbf, 0x13, 0x3d, 0xaf, 0x8b, 0x9f, 0xab, 0x1a, 0x19, 0x63, 0xbb, 0xe9, 0xba, 0xeb, 0x21, 0xff,
-	0x7a, 0x10, 0xac, 0xb7, 0x77, 0xd6, 0x9f, 0xed, 0xdd, 0x7d, 0xca, 0x4d, 0xb6, 0x88, 0x83, 0x44,
-	0x89, 0x30, 0x51, 0x5a, 0x28, 0x7d, 0x5c, 0xde, 0xeb, 0xe9, 0x3c, 0x34, 0xab, 0x02, 0x74, 0x30,
-	0x96, 0x26, 0xba, 0x3a, 0x76, 0x4d, 0x22, 0xdc, 0x13, 0x5c, 0xd2, 0x94, 0x69, 0x5a, 0x94, 0x3c,
-	0x01, 0xe7, 0xea, 0xbf, 0xf5, 0x86, 0x90, 0x44, 0x37, 0x82, 0xcb, 0x4f, 0x4c, 0x4f, 0x0e, 0x12,
-	0xe4, 0x3b, 0x26, 0x27, 0xcd, 0xb3, 0x57, 0xdb, 0x4f, 0x12, 0xee, 0xd7, 0xc2, 0xed, 0x0f, 0x7d,
-	0xb9, 0xb0, 0x2f, 0xfa, 0x97, 0x51, 0x9f, 0x4b, 0x6e, 0x38, 0xcb, 0x9b, 0xf1, 0x0d, 0x46, 0xeb,
-	0x9d, 0x8b, 0x36, 0x3b, 0x17, 0xfd, 0xdd, 0xb9, 0xe8, 0xe7, 0xde, 0xb5, 0x36, 0x7b, 0xd7, 0xfa,
-	0xbd, 0x77, 0xad, 0x6f, 0xef, 0xce, 0xbc, 0x60, 0x79, 0xb0, 0x6a, 0x93, 0xf5, 0xe3, 0x2c, 0x5b,
-	0x95, 0x6b, 0xdc, 0xad, 0x72, 0xf2, 0xf8, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xa5, 0xce, 0xeb, 0x97,
-	0x7f, 0x02, 0x00, 0x00,
+	// 786 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0xdd, 0x6e, 0x23, 0x35,
+	0x14, 0xc7, 0x33, 0xbb, 0x69, 0x9a, 0xb8, 0x0d, 0x9b, 0xf5, 0x66, 0xd3, 0xd9, 0x0d, 0x9b, 0x46,
+	0x8b, 0xb4, 0x8a, 0xd0, 0x92, 0x68, 0xb5, 0xb7, 0x70, 0x41, 0x48, 0x4b, 0x0b, 0x54, 0x44, 0x23,
+	0x2a, 0x24, 0x04, 0xb2, 0x9c, 0x99, 0xd3, 0x89, 0x95, 0x19, 0x7b, 0x64, 0x7b, 0x42, 0xcb, 0x53,
+	0xf0, 0x16, 0x3c, 0x0a, 0xbd, 0xec, 0x25, 0xe2, 0xa2, 0x42, 0xed, 0x8b, 0x20, 0x3b, 0xf3, 0x91,
+	0x0a, 0x6e, 0x76, 0xae, 0x12, 0xfb, 0x1c, 0xff, 0xfe, 0xc7, 0x3e, 0xe7, 0xcc, 0x41, 0x6f, 0x40,
+	0x2f, 0x41, 0xc6, 0x8c, 0xeb, 0xc9, 0x05, 0x40, 0x4c, 0xe5, 0x0a, 0xf4, 0x64, 0xfd, 0xae, 0x5c,
+	0x8c, 0x13, 0x29, 0xb4, 0xc0, 0xbd, 0xc2, 0x6f, 0x5c, 0x9a, 0xd6, 0xef, 0x5e, 0x76, 0x43, 0x11,
+	0x0a, 0xeb, 0x32, 0x31, 0xff, 0x36, 0xde, 0xaf, 0xff, 0x68, 0xa3, 0xc6, 0x9c, 0x4a, 0x1a, 0x2b,
+	0x3c, 0x40, 0x7b, 0x5c, 0x90, 0x05, 0x55, 0x40, 0x2e, 0x00, 0x5c, 0x67, 0xe8, 0x8c, 0x9a, 0x5e,
+	0x8b, 0x8b, 0x29, 0x55, 0x70, 0x0c, 0x80, 0xbf, 0x40, 0xfd, 0xdc, 0x48, 0xfc, 0x25, 0xe5, 0x21,
+	0x90, 0x00, 0xb8, 0x88, 0x19, 0xa7, 0x5a, 0x48, 0xf7, 0xd1, 0xd0, 0x19, 0xb5, 0x3d, 0x77, 0xb1,
+	0xf1, 0xfe, 0xca, 0x3a, 0xcc, 0x4a, 0x3b, 0x7e, 0x8f, 0x9e, 0x43, 0x44, 0x95, 0x66, 0x3e, 0xd3,
+	0x57, 0x24, 0x4e, 0x23, 0xcd, 0x92, 0x88, 0x81, 0x74, 0x1f, 0xdb, 0x83, 0xdd, 0xd2, 0x78, 0x56,
+	0xd8, 0xf0, 0x27, 0xa8, 0x0d, 0x9c, 0x2e, 0x22, 0x20, 0x4b, 0x60, 0xe1, 0x52, 0xbb, 0x3b, 0x43,
+	0x67, 0xf4, 0xd8, 0xdb, 0xdf, 0x6c, 0x9e, 0xd8, 0x3d, 0x7c, 0x8a, 0x9a, 0x45, 0xd4, 0x8d, 0xa1,
+	0x33, 0x6a, 0x4d, 0xc7, 0xd7, 0xb7, 0x87, 0xb5, 0xbf, 0x6f, 0x0f, 0xdf, 0x84, 0x4c, 0x2f, 0xd3,
+	0xc5, 0xd8, 0x17, 0xf1, 0xc4, 0x17, 0x2a, 0x16, 0x2a, 0xfb, 0xf9, 0x4c, 0x05, 0xab, 0x89, 0xbe,
+	0x4a, 0x40, 0x8d, 0x4f, 0xb9, 0xf6, 0x76, 0xb3, 0xa8, 0xb1, 0x87, 0xda, 0x31, 0xe3, 0x24, 0xa4,
+	0x8a, 0x24, 0x92, 0xf9, 0xe0, 0xee, 0x7e, 0x30, 0x6f, 0x06, 0xbe, 0xb7, 0x17, 0x33, 0xfe, 0x35,
+	0x55, 0x73, 0x83, 0xc0, 0x3f, 0x23, 0x9c, 0x33, 0xb7, 0x6e, 0xdd, 0xac, 0x04, 0xee, 0x6c, 0xc0,
+	0x5b, 0x2f, 0xf4, 0x0b, 0x7a, 0x56, 0x64, 0x65, 0x91, 0x4a, 0x4e, 0x24, 0xd5, 0x4c, 0xb8, 0xad,
+	0x6a, 0xf8, 0xec, 0x1d, 0xa6, 0xa9, 0xe4, 0x9e, 0xe1, 0xe0, 0x04, 0xbd, 0x2a, 0x93, 0x2e, 0xe2,
+	0x38, 0xe5, 0x26, 0x7b, 0x89, 0x10, 0x51, 0x26, 0x84, 0x2a, 0x09, 0xbd, 0xc8, 0xcb, 0x24, 0x47,
+	0xce, 0x85, 0x88, 0x36, 0x8a, 0x80, 0x0e, 0x0a, 0xc5, 0x44, 0x8a, 0x44, 0x28, 0x90, 0x99, 0xd6,
+	0x5e, 0x25, 0xad, 0x6e, 0xa6, 0x35, 0xcf, 0x60, 0x1b, 0x99, 0xcf, 0x51, 0xff, 0x41, 0xa6, 0x89,
+	0x90, 0xd4, 0x8f, 0x80, 0x2c, 0x22, 0xe1, 0xaf, 0x94, 0xbb, 0x6f, 0x8b, 0xf2, 0x60, 0x2b, 0x8f,
+	0xdf, 0x5b, 0xfb, 0xd4, 0x9a, 0xf1, 0x0c, 0x1d, 0xfe, 0xef, 0xe9, 0x04, 0xa4, 0x0f, 0x5c, 0xb3,
+	0x08, 0xdc, 0xb6, 0x25, 0xf4, 0xff, 0x43, 0x98, 0x17, 0x2e, 0xf8, 0x2d, 0xc2, 0xe6, 0x96, 0x4b,
+	0xa6, 0xb4, 0x90, 0x57, 0xb9, 0xf4, 0x47, 0xf6, 0x60, 0xe7, 0x02, 0xe0, 0x64, 0x63, 0xc8, 0x34,
+	0xc7, 0x5b, 0x99, 0xf6, 0x69, 0xe4, 0xa7, 0x91, 0xed, 0xbb, 0x27, 0xe6, 0x51, 0xbc, 0xa7, 0xf9,
+	0x83, 0x16, 0x06, 0x3c, 0x47, 0xfb, 0x26, 0xc6, 0xa2, 0x35, 0x3a, 0x95, 0x5a, 0x03, 0xc5, 0x8c,
+	0xe7, 0x5f, 0x00, 0x43, 0xa4, 0x97, 0x25, 0xf1, 0x69, 0x45, 0x22, 0xbd, 0xcc, 0x89, 0x01, 0xea,
+	0x51, 0x16, 0x07, 0x84, 0x06, 0x01, 0xd3, 0x6c, 0x0d, 0x84, 0x71, 0x5f, 0x02, 0x55, 0xe0, 0xe2,
+	0x4a, 0xec, 0xae, 0xa1, 0x7d, 0x99, 0xc1, 0x4e, 0x33, 0x16, 0x4e, 0xd0, 0xc7, 0x56, 0x25, 0x6f,
+	0x3f, 0x9f, 0x5a, 0xad, 0x00, 0x32, 0xad, 0x67, 0x95, 0xea, 0xea, 0xa5, 0x61, 0x9e, 0x3d, 0x40,
+	0xce, 0x32, 0xa2, 0xb9, 0x57, 0xc2, 0x02, 0xa2, 0xa9, 0x0c, 0x41, 0x93, 0x54, 0xb3, 0x88, 0xfd,
+	0x66, 0xca, 0x8e, 0xbb, 0xdd, 0x6a, 0x35, 0x9c, 0xb0, 0xe0, 0x07, 0x0b, 0x3b, 0x2f, 0x59, 0xf8,
+	0x08, 0x35, 0x8c, 0xca, 0x2a, 0x71, 0x9f, 0x57, 0xa2, 0xee, 0x24, 0x2c, 0xf8, 0x36, 0x29, 0x30,
+	0xcc, 0xed, 0x55, 0xc7, 0xb0, 0x02, 0x13, 0xb8, 0x07, 0xd5, 0x31, 0xc1, 0x37, 0xf5, 0x66, 0xbd,
+	0xb3, 0xe3, 0x75, 0x18, 0x67, 0x9a, 0xd1, 0xa8, 0x28, 0xb6, 0xd7, 0x7f, 0x3a, 0xe8, 0x89, 0xed,
+	0x84, 0xe3, 0xa2, 0x31, 0x70, 0x0f, 0x35, 0xb2, 0xb9, 0xe0, 0xd8, 0xb9, 0x90, 0xad, 0xf0, 0xd1,
+	0xd6, 0x44, 0x78, 0x64, 0x83, 0xf9, 0xb4, 0xca, 0x34, 0x78, 0x81, 0x9a, 0xa6, 0xc3, 0x53, 0x05,
+	0x81, 0x9d, 0x52, 0x75, 0x6f, 0x37, 0xa4, 0xea, 0x5c, 0x41, 0x80, 0x5f, 0x21, 0x64, 0x4c, 0xbf,
+	0x52, 0xae, 0x21, 0x70, 0xeb, 0xd6, 0xd8, 0x0a, 0xa9, 0xfa, 0xd1, 0x6e, 0xe0, 0x3e, 0x32, 0x0b,
+	0x12, 0xb1, 0x98, 0xe5, 0x33, 0xcb, 0xa0, 0xbe, 0x33, 0xeb, 0xe9, 0xf1, 0xf5, 0xdd, 0xc0, 0xb9,
+	0xb9, 0x1b, 0x38, 0xff, 0xdc, 0x0d, 0x9c, 0xdf, 0xef, 0x07, 0xb5, 0x9b, 0xfb, 0x41, 0xed, 0xaf,
+	0xfb, 0x41, 0xed, 0xa7, 0xb7, 0x5b, 0x11, 0xc2, 0xda, 0x04, 0x58, 0x0e, 0xfd, 0xcb, 0xad, 0xb1,
+	0x6f, 0x63, 0x5d, 0x34, 0xec, 0x08, 0x7f, 0xff, 0xef, 0x00, 0x84, 0xbb, 0x28, 0xd9, 0x1a, 0x08,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -163,6 +337,154 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PidKd.Size()
+		i -= size
+		if _, err := m.PidKd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	{
+		size := m.PidKi.Size()
+		i -= size
+		if _, err := m.PidKi.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	{
+		size := m.PidKp.Size()
+		i -= size
+		if _, err := m.PidKp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	{
+		size := m.PidTargetUtilization.Size()
+		i -= size
+		if _, err := m.PidTargetUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	{
+		size := m.AimdMultiplicativeDecrease.Size()
+		i -= size
+		if _, err := m.AimdMultiplicativeDecrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.AimdAdditiveIncrease.Size()
+		i -= size
+		if _, err := m.AimdAdditiveIncrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.MaxBaseFee.Size()
+		i -= size
+		if _, err := m.MaxBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.MinBaseFee.Size()
+		i -= size
+		if _, err := m.MinBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.BaseFeeCalculator) > 0 {
+		i -= len(m.BaseFeeCalculator)
+		copy(dAtA[i:], m.BaseFeeCalculator)
+		i = encodeVarintFeemarket(dAtA, i, uint64(len(m.BaseFeeCalculator)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.FeeHistoryBlocks != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.FeeHistoryBlocks))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MinGasPriceOraclePercentile != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MinGasPriceOraclePercentile))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MinGasPriceOracleBlocks != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MinGasPriceOracleBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.BaseFeeProposerRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeProposerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.BaseFeeCommunityPoolRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeCommunityPoolRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BaseFeeBurnRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -221,6 +543,61 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockFeeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFeeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFeeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GasWanted != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasWanted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BaseFee != nil {
+		{
+			size := m.BaseFee.Size()
+			i -= size
+			if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFeemarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovFeemarket(v)
 	base := offset
@@ -256,6 +633,66 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFeeBurnRatio.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFeeCommunityPoolRatio.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.BaseFeeProposerRatio.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.MinGasPriceOracleBlocks != 0 {
+		n += 1 + sovFeemarket(uint64(m.MinGasPriceOracleBlocks))
+	}
+	if m.MinGasPriceOraclePercentile != 0 {
+		n += 1 + sovFeemarket(uint64(m.MinGasPriceOraclePercentile))
+	}
+	if m.FeeHistoryBlocks != 0 {
+		n += 1 + sovFeemarket(uint64(m.FeeHistoryBlocks))
+	}
+	l = len(m.BaseFeeCalculator)
+	if l > 0 {
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	l = m.MinBaseFee.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	l = m.MaxBaseFee.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	l = m.AimdAdditiveIncrease.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	l = m.AimdMultiplicativeDecrease.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	l = m.PidTargetUtilization.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	l = m.PidKp.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	l = m.PidKi.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	l = m.PidKd.Size()
+	n += 2 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *BlockFeeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovFeemarket(uint64(l))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasWanted != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasWanted))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasLimit))
+	}
 	return n
 }
 
@@ -473,6 +910,631 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeCommunityPoolRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeCommunityPoolRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeProposerRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeProposerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceOracleBlocks", wireType)
+			}
+			m.MinGasPriceOracleBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGasPriceOracleBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceOraclePercentile", wireType)
+			}
+			m.MinGasPriceOraclePercentile = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinGasPriceOraclePercentile |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeHistoryBlocks", wireType)
+			}
+			m.FeeHistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeHistoryBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeCalculator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseFeeCalculator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AimdAdditiveIncrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AimdAdditiveIncrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AimdMultiplicativeDecrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AimdMultiplicativeDecrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidTargetUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidTargetUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidKp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidKp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidKi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidKi.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PidKd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PidKd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFeeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFeeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFeeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.BaseFee = &v
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWanted", wireType)
+			}
+			m.GasWanted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasWanted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -35,18 +35,36 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixMinGasPriceOracleSamples
+	prefixMinGasPriceOracleCount
+	prefixFeeHistory
+	prefixBlockGasUsed
+	prefixPIDIntegral
+	prefixPIDPreviousError
 )
 
 const (
 	prefixTransientBlockGasUsed = iota + 1
+	prefixTransientBaseFees
+	prefixTransientTips
+	prefixTransientTipCount
 )
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted           = []byte{prefixBlockGasWanted}
+	KeyPrefixMinGasPriceOracleSamples = []byte{prefixMinGasPriceOracleSamples}
+	KeyPrefixMinGasPriceOracleCount   = []byte{prefixMinGasPriceOracleCount}
+	KeyPrefixFeeHistory               = []byte{prefixFeeHistory}
+	KeyPrefixBlockGasUsed             = []byte{prefixBlockGasUsed}
+	KeyPrefixPIDIntegral              = []byte{prefixPIDIntegral}
+	KeyPrefixPIDPreviousError         = []byte{prefixPIDPreviousError}
 )
 
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
+	KeyPrefixTransientBaseFees       = []byte{prefixTransientBaseFees}
+	KeyPrefixTransientTips           = []byte{prefixTransientTips}
+	KeyPrefixTransientTipCount       = []byte{prefixTransientTipCount}
 )
@@ -33,6 +33,54 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultBaseFeeRatio is 0 (i.e the base fee portion of the fees is left in the fee collector)
+	DefaultBaseFeeRatio = sdk.ZeroDec()
+	// DefaultMinGasPriceOracleBlocks is 0 (i.e the min gas price oracle is disabled)
+	DefaultMinGasPriceOracleBlocks = uint32(0)
+	// DefaultMinGasPriceOraclePercentile is the 60th percentile of the effective tips of each block
+	DefaultMinGasPriceOraclePercentile = uint32(60)
+	// DefaultFeeHistoryBlocks is the fee history of the last 1024 blocks
+	DefaultFeeHistoryBlocks = uint32(1024)
+	// DefaultBaseFeeCalculator is the EIP-1559 base fee adjustment based on the block gas wanted
+	DefaultBaseFeeCalculator = BaseFeeCalculatorEIP1559
+	// DefaultMinBaseFee is 0 (i.e no lower bound other than the min gas price)
+	DefaultMinBaseFee = sdkmath.ZeroInt()
+	// DefaultMaxBaseFee is 0 (i.e unbounded)
+	DefaultMaxBaseFee = sdkmath.ZeroInt()
+	// DefaultAIMDAdditiveIncrease is 0.1 gwei
+	DefaultAIMDAdditiveIncrease = sdkmath.NewInt(100_000_000)
+	// DefaultAIMDMultiplicativeDecrease is 0.875 (i.e the maximum EIP-1559 decrease)
+	DefaultAIMDMultiplicativeDecrease = sdk.NewDecWithPrec(875, 3)
+	// DefaultPIDTargetUtilization is 0.5 or 50% of the block gas limit
+	DefaultPIDTargetUtilization = sdk.NewDecWithPrec(5, 1)
+	// DefaultPIDKp is 0.25, which matches the EIP-1559 adjustment for a 50% target
+	DefaultPIDKp = sdk.NewDecWithPrec(25, 2)
+	// DefaultPIDKi is 0.05
+	DefaultPIDKi = sdk.NewDecWithPrec(5, 2)
+	// DefaultPIDKd is 0 (i.e no derivative term)
+	DefaultPIDKd = sdk.ZeroDec()
+)
+
+// Base fee calculators available by default
+const (
+	// BaseFeeCalculatorEIP1559 adjusts the base fee following EIP-1559, using the block gas wanted
+	BaseFeeCalculatorEIP1559 = "eip1559"
+	// BaseFeeCalculatorGasUsed adjusts the base fee following EIP-1559, using the block gas used
+	BaseFeeCalculatorGasUsed = "gas_used"
+	// BaseFeeCalculatorAIMD additively increases and multiplicatively decreases the base fee
+	BaseFeeCalculatorAIMD = "aimd"
+	// BaseFeeCalculatorPID adjusts the base fee with a PID controller targeting a block utilization
+	BaseFeeCalculatorPID = "pid"
+)
+
+const (
+	// MaxMinGasPriceOracleBlocks bounds the number of blocks tracked by the min gas price oracle
+	MaxMinGasPriceOracleBlocks = 1024
+	// MaxMinGasPriceOraclePercentile is the upper bound of the min gas price oracle percentile
+	MaxMinGasPriceOraclePercentile = 100
+	// MaxFeeHistoryBlocks bounds the number of blocks whose fee history is stored, as well as
+	// the number of blocks returned by a fee history query
+	MaxFeeHistoryBlocks = 100000
 )
 
 // Parameter keys
@@ -76,26 +124,56 @@ func NewParams(
 	minGasPriceMultiplier sdk.Dec,
 ) Params {
 	return Params{
-		NoBaseFee:                noBaseFee,
-		BaseFeeChangeDenominator: baseFeeChangeDenom,
-		ElasticityMultiplier:     elasticityMultiplier,
-		BaseFee:                  sdkmath.NewIntFromUint64(baseFee),
-		EnableHeight:             enableHeight,
-		MinGasPrice:              minGasPrice,
-		MinGasMultiplier:         minGasPriceMultiplier,
+		NoBaseFee:                   noBaseFee,
+		BaseFeeChangeDenominator:    baseFeeChangeDenom,
+		ElasticityMultiplier:        elasticityMultiplier,
+		BaseFee:                     sdkmath.NewIntFromUint64(baseFee),
+		EnableHeight:                enableHeight,
+		MinGasPrice:                 minGasPrice,
+		MinGasMultiplier:            minGasPriceMultiplier,
+		BaseFeeBurnRatio:            DefaultBaseFeeRatio,
+		BaseFeeCommunityPoolRatio:   DefaultBaseFeeRatio,
+		BaseFeeProposerRatio:        DefaultBaseFeeRatio,
+		MinGasPriceOracleBlocks:     DefaultMinGasPriceOracleBlocks,
+		MinGasPriceOraclePercentile: DefaultMinGasPriceOraclePercentile,
+		FeeHistoryBlocks:            DefaultFeeHistoryBlocks,
+		BaseFeeCalculator:           DefaultBaseFeeCalculator,
+		MinBaseFee:                  DefaultMinBaseFee,
+		MaxBaseFee:                  DefaultMaxBaseFee,
+		AimdAdditiveIncrease:        DefaultAIMDAdditiveIncrease,
+		AimdMultiplicativeDecrease:  DefaultAIMDMultiplicativeDecrease,
+		PidTargetUtilization:        DefaultPIDTargetUtilization,
+		PidKp:                       DefaultPIDKp,
+		PidKi:                       DefaultPIDKi,
+		PidKd:                       DefaultPIDKd,
 	}
 }
 
 // DefaultParams returns default evm parameters
 func DefaultParams() Params {
 	return Params{
-		NoBaseFee:                DefaultNoBaseFee,
-		BaseFeeChangeDenominator: params.BaseFeeChangeDenominator,
-		ElasticityMultiplier:     params.ElasticityMultiplier,
-		BaseFee:                  sdkmath.NewIntFromUint64(params.InitialBaseFee),
-		EnableHeight:             DefaultEnableHeight,
-		MinGasPrice:              DefaultMinGasPrice,
-		MinGasMultiplier:         DefaultMinGasMultiplier,
+		NoBaseFee:                   DefaultNoBaseFee,
+		BaseFeeChangeDenominator:    params.BaseFeeChangeDenominator,
+		ElasticityMultiplier:        params.ElasticityMultiplier,
+		BaseFee:                     sdkmath.NewIntFromUint64(params.InitialBaseFee),
+		EnableHeight:                DefaultEnableHeight,
+		MinGasPrice:                 DefaultMinGasPrice,
+		MinGasMultiplier:            DefaultMinGasMultiplier,
+		BaseFeeBurnRatio:            DefaultBaseFeeRatio,
+		BaseFeeCommunityPoolRatio:   DefaultBaseFeeRatio,
+		BaseFeeProposerRatio:        DefaultBaseFeeRatio,
+		MinGasPriceOracleBlocks:     DefaultMinGasPriceOracleBlocks,
+		MinGasPriceOraclePercentile: DefaultMinGasPriceOraclePercentile,
+		FeeHistoryBlocks:            DefaultFeeHistoryBlocks,
+		BaseFeeCalculator:           DefaultBaseFeeCalculator,
+		MinBaseFee:                  DefaultMinBaseFee,
+		MaxBaseFee:                  DefaultMaxBaseFee,
+		AimdAdditiveIncrease:        DefaultAIMDAdditiveIncrease,
+		AimdMultiplicativeDecrease:  DefaultAIMDMultiplicativeDecrease,
+		PidTargetUtilization:        DefaultPIDTargetUtilization,
+		PidKp:                       DefaultPIDKp,
+		PidKi:                       DefaultPIDKi,
+		PidKd:                       DefaultPIDKd,
 	}
 }
 
@@ -117,6 +195,22 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateBaseFeeRatios(p.BaseFeeBurnRatio, p.BaseFeeCommunityPoolRatio, p.BaseFeeProposerRatio); err != nil {
+		return err
+	}
+
+	if err := validateMinGasPriceOracle(p.MinGasPriceOracleBlocks, p.MinGasPriceOraclePercentile); err != nil {
+		return err
+	}
+
+	if p.FeeHistoryBlocks > MaxFeeHistoryBlocks {
+		return fmt.Errorf("fee history blocks cannot be greater than %d: %d", MaxFeeHistoryBlocks, p.FeeHistoryBlocks)
+	}
+
+	if err := p.validateBaseFeeCalculator(); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -132,6 +226,34 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// BaseFeeRatios returns the fractions of the base fee portion of the block fees that
+// are burned, sent to the community pool and allocated to the block proposer.
+// Unset ratios (i.e params stored before their introduction) are considered zero.
+func (p Params) BaseFeeRatios() (burn, communityPool, proposer sdk.Dec) {
+	orZero := func(ratio sdk.Dec) sdk.Dec {
+		if ratio.IsNil() {
+			return sdk.ZeroDec()
+		}
+		return ratio
+	}
+
+	return orZero(p.BaseFeeBurnRatio), orZero(p.BaseFeeCommunityPoolRatio), orZero(p.BaseFeeProposerRatio)
+}
+
+// IsMinGasPriceOracleEnabled returns true if the minimum gas price tracks the
+// effective tips of the recent blocks.
+func (p Params) IsMinGasPriceOracleEnabled() bool {
+	return p.MinGasPriceOracleBlocks > 0
+}
+
+// BaseFeeCalculatorName returns the name of the base fee calculator, defaulting to EIP-1559 if unset.
+func (p Params) BaseFeeCalculatorName() string {
+	if p.BaseFeeCalculator == "" {
+		return DefaultBaseFeeCalculator
+	}
+	return p.BaseFeeCalculator
+}
+
 func validateMinGasPrice(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 
@@ -217,3 +339,104 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateBaseFeeRatios(ratios ...sdk.Dec) error {
+	total := sdk.ZeroDec()
+	for _, ratio := range ratios {
+		if ratio.IsNil() {
+			continue
+		}
+
+		if ratio.IsNegative() {
+			return fmt.Errorf("base fee ratio cannot be negative: %s", ratio)
+		}
+
+		total = total.Add(ratio)
+	}
+
+	if total.GT(sdk.OneDec()) {
+		return fmt.Errorf("sum of the base fee ratios cannot be greater than 1: %s", total)
+	}
+
+	return nil
+}
+
+func validateMinGasPriceOracle(blocks, percentile uint32) error {
+	if blocks > MaxMinGasPriceOracleBlocks {
+		return fmt.Errorf("min gas price oracle blocks cannot be greater than %d: %d", MaxMinGasPriceOracleBlocks, blocks)
+	}
+
+	if percentile > MaxMinGasPriceOraclePercentile {
+		return fmt.Errorf("min gas price oracle percentile cannot be greater than %d: %d", MaxMinGasPriceOraclePercentile, percentile)
+	}
+
+	return nil
+}
+
+// validateBaseFeeCalculator validates the base fee calculator name and the parameters of the
+// calculators. The parameters of the aimd and pid calculators may be unset (i.e params stored
+// before their introduction) unless the calculator is selected.
+func (p Params) validateBaseFeeCalculator() error {
+	for _, c := range p.BaseFeeCalculator {
+		if !(c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_') {
+			return fmt.Errorf("invalid base fee calculator name: %q", p.BaseFeeCalculator)
+		}
+	}
+
+	calculator := p.BaseFeeCalculatorName()
+	bounded := calculator == BaseFeeCalculatorAIMD || calculator == BaseFeeCalculatorPID
+
+	ints := []struct {
+		name     string
+		value    sdkmath.Int
+		required bool
+	}{
+		{"min base fee", p.MinBaseFee, bounded},
+		{"max base fee", p.MaxBaseFee, bounded},
+		{"aimd additive increase", p.AimdAdditiveIncrease, calculator == BaseFeeCalculatorAIMD},
+	}
+	for _, param := range ints {
+		if param.value.IsNil() {
+			if param.required {
+				return fmt.Errorf("%s cannot be nil for the %s base fee calculator", param.name, calculator)
+			}
+			continue
+		}
+		if param.value.IsNegative() {
+			return fmt.Errorf("%s cannot be negative: %s", param.name, param.value)
+		}
+	}
+
+	if !p.MinBaseFee.IsNil() && !p.MaxBaseFee.IsNil() && p.MaxBaseFee.IsPositive() && p.MaxBaseFee.LT(p.MinBaseFee) {
+		return fmt.Errorf("max base fee cannot be lower than the min base fee: %s < %s", p.MaxBaseFee, p.MinBaseFee)
+	}
+
+	decs := []struct {
+		name     string
+		value    sdk.Dec
+		required bool
+		positive bool
+	}{
+		{"aimd multiplicative decrease", p.AimdMultiplicativeDecrease, calculator == BaseFeeCalculatorAIMD, true},
+		{"pid target utilization", p.PidTargetUtilization, calculator == BaseFeeCalculatorPID, true},
+		{"pid kp", p.PidKp, calculator == BaseFeeCalculatorPID, false},
+		{"pid ki", p.PidKi, calculator == BaseFeeCalculatorPID, false},
+		{"pid kd", p.PidKd, calculator == BaseFeeCalculatorPID, false},
+	}
+	for _, param := range decs {
+		if param.value.IsNil() {
+			if param.required {
+				return fmt.Errorf("%s cannot be nil for the %s base fee calculator", param.name, calculator)
+			}
+			continue
+		}
+		if param.value.IsNegative() {
+			return fmt.Errorf("%s cannot be negative: %s", param.name, param.value)
+		}
+		if param.positive && (param.value.IsZero() || param.value.GT(sdk.OneDec())) {
+			return fmt.Errorf("%s must be in the (0, 1] range: %s", param.name, param.value)
+		}
+	}
+
+	return nil
+}
@@ -59,6 +59,166 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), sdk.NewDec(2)),
 			true,
 		},
+		{
+			"valid: base fee ratios",
+			Params{
+				BaseFeeChangeDenominator:  7,
+				BaseFee:                   sdkmath.NewInt(2000000000),
+				MinGasPrice:               DefaultMinGasPrice,
+				MinGasMultiplier:          DefaultMinGasMultiplier,
+				BaseFeeBurnRatio:          sdk.NewDecWithPrec(5, 1),
+				BaseFeeCommunityPoolRatio: sdk.NewDecWithPrec(3, 1),
+				BaseFeeProposerRatio:      sdk.NewDecWithPrec(2, 1),
+			},
+			false,
+		},
+		{
+			"valid: unset base fee ratios",
+			Params{
+				BaseFeeChangeDenominator: 7,
+				BaseFee:                  sdkmath.NewInt(2000000000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+			},
+			false,
+		},
+		{
+			"invalid: negative base fee ratio",
+			Params{
+				BaseFeeChangeDenominator:  7,
+				BaseFee:                   sdkmath.NewInt(2000000000),
+				MinGasPrice:               DefaultMinGasPrice,
+				MinGasMultiplier:          DefaultMinGasMultiplier,
+				BaseFeeBurnRatio:          sdk.NewDecWithPrec(-1, 1),
+				BaseFeeCommunityPoolRatio: sdk.ZeroDec(),
+				BaseFeeProposerRatio:      sdk.ZeroDec(),
+			},
+			true,
+		},
+		{
+			"invalid: base fee ratios sum bigger than 1",
+			Params{
+				BaseFeeChangeDenominator:  7,
+				BaseFee:                   sdkmath.NewInt(2000000000),
+				MinGasPrice:               DefaultMinGasPrice,
+				MinGasMultiplier:          DefaultMinGasMultiplier,
+				BaseFeeBurnRatio:          sdk.NewDecWithPrec(5, 1),
+				BaseFeeCommunityPoolRatio: sdk.NewDecWithPrec(5, 1),
+				BaseFeeProposerRatio:      sdk.NewDecWithPrec(1, 1),
+			},
+			true,
+		},
+		{
+			"valid: min gas price oracle enabled",
+			Params{
+				BaseFeeChangeDenominator:    7,
+				BaseFee:                     sdkmath.NewInt(2000000000),
+				MinGasPrice:                 DefaultMinGasPrice,
+				MinGasMultiplier:            DefaultMinGasMultiplier,
+				MinGasPriceOracleBlocks:     MaxMinGasPriceOracleBlocks,
+				MinGasPriceOraclePercentile: MaxMinGasPriceOraclePercentile,
+			},
+			false,
+		},
+		{
+			"invalid: min gas price oracle blocks too large",
+			Params{
+				BaseFeeChangeDenominator: 7,
+				BaseFee:                  sdkmath.NewInt(2000000000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				MinGasPriceOracleBlocks:  MaxMinGasPriceOracleBlocks + 1,
+			},
+			true,
+		},
+		{
+			"invalid: min gas price oracle percentile bigger than 100",
+			Params{
+				BaseFeeChangeDenominator:    7,
+				BaseFee:                     sdkmath.NewInt(2000000000),
+				MinGasPrice:                 DefaultMinGasPrice,
+				MinGasMultiplier:            DefaultMinGasMultiplier,
+				MinGasPriceOracleBlocks:     10,
+				MinGasPriceOraclePercentile: MaxMinGasPriceOraclePercentile + 1,
+			},
+			true,
+		},
+		{
+			"invalid: fee history blocks too large",
+			Params{
+				BaseFeeChangeDenominator: 7,
+				BaseFee:                  sdkmath.NewInt(2000000000),
+				MinGasPrice:              DefaultMinGasPrice,
+				MinGasMultiplier:         DefaultMinGasMultiplier,
+				FeeHistoryBlocks:         MaxFeeHistoryBlocks + 1,
+			},
+			true,
+		},
+		{
+			"valid: pid base fee calculator",
+			func() Params {
+				params := DefaultParams()
+				params.BaseFeeCalculator = BaseFeeCalculatorPID
+				return params
+			}(),
+			false,
+		},
+		{
+			"invalid: base fee calculator name",
+			func() Params {
+				params := DefaultParams()
+				params.BaseFeeCalculator = "Invalid Name"
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: aimd base fee calculator without its parameters",
+			func() Params {
+				params := DefaultParams()
+				params.BaseFeeCalculator = BaseFeeCalculatorAIMD
+				params.AimdMultiplicativeDecrease = sdk.Dec{}
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: zero aimd multiplicative decrease",
+			func() Params {
+				params := DefaultParams()
+				params.AimdMultiplicativeDecrease = sdk.ZeroDec()
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: max base fee lower than the min base fee",
+			func() Params {
+				params := DefaultParams()
+				params.MinBaseFee = sdkmath.NewInt(10)
+				params.MaxBaseFee = sdkmath.NewInt(5)
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: pid target utilization bigger than 1",
+			func() Params {
+				params := DefaultParams()
+				params.PidTargetUtilization = sdk.NewDec(2)
+				return params
+			}(),
+			true,
+		},
+		{
+			"invalid: negative pid gain",
+			func() Params {
+				params := DefaultParams()
+				params.PidKi = sdk.NewDec(-1)
+				return params
+			}(),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,148 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/revenue/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(
+		NewRegisterRevenueCmd(),
+		NewUpdateRevenueCmd(),
+		NewCancelRevenueCmd(),
+	)
+	return cmd
+}
+
+// NewRegisterRevenueCmd registers a contract deployed by the sender for revenue
+func NewRegisterRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register CONTRACT_HEX NONCES [WITHDRAWER_ADDRESS]",
+		Short: "Register a contract deployed by the sender to receive a share of the fees of the txs calling it",
+		Long: `Register a contract deployed by the sender to receive a share of the fees of the txs calling it.
+NONCES is the comma separated list of the nonce of the sender deploying the contract, or the factory
+contract deploying it, followed by the nonces of each factory contract in the deployment chain.
+The fees are sent to the sender if the withdrawer address is not provided.`,
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var nonces []uint64
+			for _, nonce := range strings.Split(args[1], ",") {
+				n, err := strconv.ParseUint(strings.TrimSpace(nonce), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid nonce %s: %w", nonce, err)
+				}
+				nonces = append(nonces, n)
+			}
+
+			var withdrawer sdk.AccAddress
+			if len(args) == 3 {
+				if withdrawer, err = sdk.AccAddressFromBech32(args[2]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRegisterRevenue(args[0], clientCtx.GetFromAddress(), withdrawer, nonces)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateRevenueCmd updates the withdrawer of a contract registered by the sender
+func NewUpdateRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update CONTRACT_HEX WITHDRAWER_ADDRESS",
+		Short: "Update the withdrawer address of a contract registered by the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRevenue(args[0], clientCtx.GetFromAddress(), withdrawer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelRevenueCmd cancels the registration of a contract registered by the sender
+func NewCancelRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel CONTRACT_HEX",
+		Short: "Cancel the revenue registration of a contract registered by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRevenue(args[0], clientCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestQueryRevenues() {
+	deployer := sdk.AccAddress(suite.deployer.Bytes())
+	contract := tests.GenerateAddress()
+	other := tests.GenerateAddress()
+	suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(contract, deployer, nil))
+	suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(other, deployer, suite.withdrawer))
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := suite.queryClient.Revenues(ctx, &types.QueryRevenuesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Revenues, 2)
+
+	revenueRes, err := suite.queryClient.Revenue(ctx, &types.QueryRevenueRequest{ContractAddress: contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(deployer.String(), revenueRes.Revenue.DeployerAddress)
+
+	_, err = suite.queryClient.Revenue(ctx, &types.QueryRevenueRequest{ContractAddress: tests.GenerateAddress().Hex()})
+	suite.Require().Error(err)
+
+	_, err = suite.queryClient.Revenue(ctx, &types.QueryRevenueRequest{ContractAddress: "invalid"})
+	suite.Require().Error(err)
+
+	deployerRes, err := suite.queryClient.DeployerRevenues(ctx, &types.QueryDeployerRevenuesRequest{DeployerAddress: deployer.String()})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]string{contract.Hex(), other.Hex()}, deployerRes.ContractAddresses)
+
+	withdrawerRes, err := suite.queryClient.WithdrawerRevenues(ctx, &types.QueryWithdrawerRevenuesRequest{WithdrawerAddress: suite.withdrawer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{other.Hex()}, withdrawerRes.ContractAddresses)
+
+	paramsRes, err := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.app.RevenueKeeper.GetParams(suite.ctx), paramsRes.Params)
+}
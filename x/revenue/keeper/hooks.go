@@ -0,0 +1,99 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/evmos/ethermint/x/revenue/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for the revenue keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the EVM hooks distributing the fees of the txs calling the registered contracts
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. It sends the developer shares of the fees
+// paid for the gas used by a tx calling a registered contract to the contract withdrawer.
+func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	contract := msg.To()
+	if contract == nil {
+		return nil
+	}
+
+	params := h.k.GetParams(ctx)
+	if !params.EnableRevenue || params.DeveloperShares.IsNil() || params.DeveloperShares.IsZero() {
+		return nil
+	}
+
+	revenue, found := h.k.GetRevenue(ctx, *contract)
+	if !found {
+		return nil
+	}
+
+	fees := h.k.txFees(ctx, msg, receipt)
+	developerFees, _ := sdk.NewDecCoinsFromCoins(fees).MulDecTruncate(params.DeveloperShares).TruncateDecimal()
+	if developerFees.IsZero() {
+		return nil
+	}
+
+	withdrawer := revenue.GetWithdrawerAddr()
+	if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, withdrawer, developerFees); err != nil {
+		return errorsmod.Wrapf(err, "failed to distribute the fees of contract %s to %s", contract, withdrawer)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeRevenue,
+			sdk.NewAttribute(types.AttributeKeySender, msg.From().String()),
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, withdrawer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, developerFees.String()),
+		),
+	)
+
+	return nil
+}
+
+// txFees returns the fees paid for the gas used by the tx at its effective gas price, in the
+// denomination the fees were paid in.
+func (k Keeper) txFees(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) sdk.Coin {
+	gasUsed := new(big.Int).SetUint64(receipt.GasUsed)
+
+	if paid, found := k.evmKeeper.GetTxFeesTransient(ctx, receipt.TxHash); found && msg.Gas() > 0 {
+		amount := new(big.Int).Mul(paid.Amount.BigInt(), gasUsed)
+		amount.Quo(amount, new(big.Int).SetUint64(msg.Gas()))
+		return sdk.NewCoin(paid.Denom, sdkmath.NewIntFromBigInt(amount))
+	}
+
+	amount := new(big.Int).Mul(msg.GasPrice(), gasUsed)
+	return sdk.NewCoin(k.evmKeeper.GetParams(ctx).EvmDenom, sdkmath.NewIntFromBigInt(amount))
+}
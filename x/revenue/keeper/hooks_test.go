@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/testutil"
+	"github.com/evmos/ethermint/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	var contract common.Address
+	deployer := sdk.AccAddress(suite.deployer.Bytes())
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		to           func() *common.Address
+		expRevenue   int64
+		toWithdrawer bool
+	}{
+		{
+			"developer shares sent to the deployer",
+			func() {},
+			func() *common.Address { return &contract },
+			// 50% of 21000 gas used at 10 gas price
+			105000,
+			false,
+		},
+		{
+			"developer shares sent to the withdrawer",
+			func() {
+				suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(contract, deployer, suite.withdrawer))
+			},
+			func() *common.Address { return &contract },
+			105000,
+			true,
+		},
+		{
+			"unregistered contract",
+			func() {},
+			func() *common.Address {
+				addr := tests.GenerateAddress()
+				return &addr
+			},
+			0,
+			false,
+		},
+		{
+			"contract creation",
+			func() {},
+			func() *common.Address { return nil },
+			0,
+			false,
+		},
+		{
+			"revenue disabled",
+			func() {
+				params := types.DefaultParams()
+				params.EnableRevenue = false
+				suite.Require().NoError(suite.app.RevenueKeeper.SetParams(suite.ctx, params))
+			},
+			func() *common.Address { return &contract },
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			deployer = sdk.AccAddress(suite.deployer.Bytes())
+			contract = suite.deployContract(suite.deployer, 1)
+			suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(contract, deployer, nil))
+			tc.malleate()
+
+			// the fees of the gas limit are collected in the ante handler
+			fees := sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 300000))
+			suite.Require().NoError(testutil.FundModuleAccount(suite.app.BankKeeper, suite.ctx, authtypes.FeeCollectorName, fees))
+
+			gasPrice := big.NewInt(10)
+			msg := ethtypes.NewMessage(suite.deployer, tc.to(), 0, big.NewInt(0), 30000, gasPrice, gasPrice, gasPrice, nil, nil, true)
+			receipt := &ethtypes.Receipt{GasUsed: 21000, TxHash: common.BytesToHash([]byte("tx"))}
+
+			err := suite.app.RevenueKeeper.Hooks().PostTxProcessing(suite.ctx, msg, receipt)
+			suite.Require().NoError(err)
+
+			withdrawer := deployer
+			if tc.toWithdrawer {
+				withdrawer = suite.withdrawer
+			}
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, withdrawer, suite.denom)
+			suite.Require().Equal(tc.expRevenue, balance.Amount.Int64())
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/evmos/ethermint/app"
+	"github.com/evmos/ethermint/tests"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/evmos/ethermint/x/revenue/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.EthermintApp
+	queryClient types.QueryClient
+	deployer    common.Address
+	withdrawer  sdk.AccAddress
+	denom       string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.app = app.Setup(false, nil)
+	suite.ctx = suite.app.BaseApp.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "ethermint_9000-1",
+		Time:    time.Now().UTC(),
+	})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx, suite.app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.app.RevenueKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+
+	suite.deployer = tests.GenerateAddress()
+	suite.withdrawer = sdk.AccAddress(tests.GenerateAddress().Bytes())
+	suite.denom = evmtypes.DefaultEVMDenom
+}
+
+// deployContract sets a contract code at the address created by the deployer with the nonce
+func (suite *KeeperTestSuite) deployContract(deployer common.Address, nonce uint64) common.Address {
+	contract := crypto.CreateAddress(deployer, nonce)
+	db := statedb.New(suite.ctx, suite.app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.ctx.HeaderHash().Bytes())))
+	db.SetCode(contract, []byte{0x60, 0x00})
+	suite.Require().NoError(db.Commit())
+	return contract
+}
+
+func (suite *KeeperTestSuite) TestRevenueStore() {
+	k := suite.app.RevenueKeeper
+	contract := tests.GenerateAddress()
+	deployer := sdk.AccAddress(suite.deployer.Bytes())
+
+	_, found := k.GetRevenue(suite.ctx, contract)
+	suite.Require().False(found)
+
+	k.SetRevenue(suite.ctx, types.NewRevenue(contract, deployer, nil))
+	revenue, found := k.GetRevenue(suite.ctx, contract)
+	suite.Require().True(found)
+	suite.Require().Equal(deployer, revenue.GetWithdrawerAddr())
+	suite.Require().Equal([]common.Address{contract}, k.GetDeployerRevenues(suite.ctx, deployer))
+	suite.Require().Equal([]common.Address{contract}, k.GetWithdrawerRevenues(suite.ctx, deployer))
+
+	// updating the withdrawer moves the withdrawer index
+	k.SetRevenue(suite.ctx, types.NewRevenue(contract, deployer, suite.withdrawer))
+	suite.Require().Empty(k.GetWithdrawerRevenues(suite.ctx, deployer))
+	suite.Require().Equal([]common.Address{contract}, k.GetWithdrawerRevenues(suite.ctx, suite.withdrawer))
+	suite.Require().Len(k.GetRevenues(suite.ctx), 1)
+
+	revenue, _ = k.GetRevenue(suite.ctx, contract)
+	k.DeleteRevenue(suite.ctx, revenue)
+	suite.Require().False(k.IsRevenueRegistered(suite.ctx, contract))
+	suite.Require().Empty(k.GetDeployerRevenues(suite.ctx, deployer))
+	suite.Require().Empty(k.GetWithdrawerRevenues(suite.ctx, suite.withdrawer))
+}
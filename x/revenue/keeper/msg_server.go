@@ -0,0 +1,193 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/revenue/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// RegisterRevenue implements the gRPC MsgServer interface. It registers a contract deployed by the
+// sender, directly or through factory contracts, to receive a share of the fees of the txs calling it.
+// The ownership is proven by the nonces deriving the contract address from the deployer address.
+func (k *Keeper) RegisterRevenue(goCtx context.Context, msg *types.MsgRegisterRevenue) (*types.MsgRegisterRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if k.IsRevenueRegistered(ctx, contract) {
+		return nil, errorsmod.Wrapf(types.ErrRevenueAlreadyRegistered, "contract %s", contract)
+	}
+
+	deployer := sdk.MustAccAddressFromBech32(msg.DeployerAddress)
+	deployerAccount := k.evmKeeper.GetAccountWithoutBalance(ctx, common.BytesToAddress(deployer))
+	if deployerAccount != nil && deployerAccount.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrRevenueDeployerIsNotEOA, "deployer %s", msg.DeployerAddress)
+	}
+
+	withdrawer, err := k.validateWithdrawer(msg.WithdrawerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// derive the contract address from the deployer address through the factories chain
+	derived := common.BytesToAddress(deployer)
+	for _, nonce := range msg.Nonces {
+		ctx.GasMeter().ConsumeGas(params.AddrDerivationCostCreate, "revenue registration: address derivation CREATE opcode")
+		derived = crypto.CreateAddress(derived, nonce)
+	}
+
+	if derived != contract {
+		return nil, errorsmod.Wrapf(types.ErrRevenueInvalidProof, "nonces derive %s instead of contract %s", derived, contract)
+	}
+
+	contractAccount := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if contractAccount == nil || !contractAccount.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrRevenueNoContractDeployed, "contract %s", contract)
+	}
+
+	revenue := types.NewRevenue(contract, deployer, withdrawer)
+	k.SetRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, revenue.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, revenue.GetWithdrawerAddr().String()),
+		),
+	)
+
+	return &types.MsgRegisterRevenueResponse{}, nil
+}
+
+// UpdateRevenue implements the gRPC MsgServer interface. It updates the withdrawer address of a
+// contract registered by the sender.
+func (k *Keeper) UpdateRevenue(goCtx context.Context, msg *types.MsgUpdateRevenue) (*types.MsgUpdateRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.GetParams(ctx).EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	revenue, err := k.getDeployerRevenue(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawer, err := k.validateWithdrawer(msg.WithdrawerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue = types.NewRevenue(revenue.GetContractAddr(), revenue.GetDeployerAddr(), withdrawer)
+	k.SetRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, revenue.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawer, revenue.GetWithdrawerAddr().String()),
+		),
+	)
+
+	return &types.MsgUpdateRevenueResponse{}, nil
+}
+
+// CancelRevenue implements the gRPC MsgServer interface. It cancels the registration of a contract
+// registered by the sender.
+func (k *Keeper) CancelRevenue(goCtx context.Context, msg *types.MsgCancelRevenue) (*types.MsgCancelRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	revenue, err := k.getDeployerRevenue(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyDeployer, revenue.DeployerAddress),
+		),
+	)
+
+	return &types.MsgCancelRevenueResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. When an UpdateParams
+// proposal passes, it updates the module parameters. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// getDeployerRevenue returns the registration of a contract, checking it's registered by the deployer.
+func (k Keeper) getDeployerRevenue(ctx sdk.Context, contractAddress, deployer string) (types.Revenue, error) {
+	revenue, found := k.GetRevenue(ctx, common.HexToAddress(contractAddress))
+	if !found {
+		return types.Revenue{}, errorsmod.Wrapf(types.ErrRevenueNotFound, "contract %s", contractAddress)
+	}
+
+	if revenue.DeployerAddress != deployer {
+		return types.Revenue{}, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized, "contract %s is registered by %s, not %s", contractAddress, revenue.DeployerAddress, deployer,
+		)
+	}
+
+	return revenue, nil
+}
+
+// validateWithdrawer parses the withdrawer address, empty if not set, and checks it can receive the fees.
+func (k Keeper) validateWithdrawer(withdrawerAddress string) (sdk.AccAddress, error) {
+	if withdrawerAddress == "" {
+		return nil, nil
+	}
+
+	withdrawer := sdk.MustAccAddressFromBech32(withdrawerAddress)
+	if k.bankKeeper.BlockedAddr(withdrawer) {
+		return nil, errorsmod.Wrapf(types.ErrRevenueInvalidWithdrawer, "%s is not allowed to receive funds", withdrawerAddress)
+	}
+
+	return withdrawer, nil
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterRevenue() {
+	var (
+		contract common.Address
+		nonces   []uint64
+	)
+	deployer := sdk.AccAddress(suite.deployer.Bytes())
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		withdrawer sdk.AccAddress
+		expErr     error
+	}{
+		{
+			"pass - deployed by the deployer",
+			func() {
+				contract = suite.deployContract(suite.deployer, 1)
+				nonces = []uint64{1}
+			},
+			nil,
+			nil,
+		},
+		{
+			"pass - deployed by a factory, with withdrawer",
+			func() {
+				factory := suite.deployContract(suite.deployer, 2)
+				contract = suite.deployContract(factory, 5)
+				nonces = []uint64{2, 5}
+			},
+			suite.withdrawer,
+			nil,
+		},
+		{
+			"fail - revenue disabled",
+			func() {
+				contract = suite.deployContract(suite.deployer, 1)
+				nonces = []uint64{1}
+				params := types.DefaultParams()
+				params.EnableRevenue = false
+				suite.Require().NoError(suite.app.RevenueKeeper.SetParams(suite.ctx, params))
+			},
+			nil,
+			types.ErrRevenueDisabled,
+		},
+		{
+			"fail - already registered",
+			func() {
+				contract = suite.deployContract(suite.deployer, 1)
+				nonces = []uint64{1}
+				suite.app.RevenueKeeper.SetRevenue(suite.ctx, types.NewRevenue(contract, deployer, nil))
+			},
+			nil,
+			types.ErrRevenueAlreadyRegistered,
+		},
+		{
+			"fail - wrong nonce",
+			func() {
+				contract = suite.deployContract(suite.deployer, 1)
+				nonces = []uint64{2}
+			},
+			nil,
+			types.ErrRevenueInvalidProof,
+		},
+		{
+			"fail - no contract deployed",
+			func() {
+				contract = crypto.CreateAddress(suite.deployer, 1)
+				nonces = []uint64{1}
+			},
+			nil,
+			types.ErrRevenueNoContractDeployed,
+		},
+		{
+			"fail - deployer is a contract",
+			func() {
+				suite.deployContract(common.Address{}, 0)
+				suite.deployer = crypto.CreateAddress(common.Address{}, 0)
+				deployer = sdk.AccAddress(suite.deployer.Bytes())
+				contract = suite.deployContract(suite.deployer, 1)
+				nonces = []uint64{1}
+			},
+			nil,
+			types.ErrRevenueDeployerIsNotEOA,
+		},
+		{
+			"fail - withdrawer is a blocked module account",
+			func() {
+				contract = suite.deployContract(suite.deployer, 1)
+				nonces = []uint64{1}
+			},
+			authtypes.NewModuleAddress(authtypes.FeeCollectorName),
+			types.ErrRevenueInvalidWithdrawer,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			deployer = sdk.AccAddress(suite.deployer.Bytes())
+			suite.Require().NoError(suite.app.RevenueKeeper.SetParams(suite.ctx, types.DefaultParams()))
+			tc.malleate()
+
+			msg := types.NewMsgRegisterRevenue(contract.Hex(), deployer, tc.withdrawer, nonces)
+			_, err := suite.app.RevenueKeeper.RegisterRevenue(sdk.WrapSDKContext(suite.ctx), msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+
+			suite.Require().NoError(err)
+			revenue, found := suite.app.RevenueKeeper.GetRevenue(suite.ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(deployer.String(), revenue.DeployerAddress)
+			if tc.withdrawer != nil {
+				suite.Require().Equal(tc.withdrawer, revenue.GetWithdrawerAddr())
+			} else {
+				suite.Require().Equal(deployer, revenue.GetWithdrawerAddr())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateCancelRevenue() {
+	deployer := sdk.AccAddress(suite.deployer.Bytes())
+	contract := suite.deployContract(suite.deployer, 1)
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.RevenueKeeper
+
+	_, err := k.UpdateRevenue(ctx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, suite.withdrawer))
+	suite.Require().ErrorIs(err, types.ErrRevenueNotFound)
+
+	_, err = k.RegisterRevenue(ctx, types.NewMsgRegisterRevenue(contract.Hex(), deployer, nil, []uint64{1}))
+	suite.Require().NoError(err)
+
+	// only the deployer can update or cancel the registration
+	_, err = k.UpdateRevenue(ctx, types.NewMsgUpdateRevenue(contract.Hex(), suite.withdrawer, suite.withdrawer))
+	suite.Require().Error(err)
+	_, err = k.CancelRevenue(ctx, types.NewMsgCancelRevenue(contract.Hex(), suite.withdrawer))
+	suite.Require().Error(err)
+
+	_, err = k.UpdateRevenue(ctx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, suite.withdrawer))
+	suite.Require().NoError(err)
+	revenue, _ := k.GetRevenue(suite.ctx, contract)
+	suite.Require().Equal(suite.withdrawer, revenue.GetWithdrawerAddr())
+
+	_, err = k.CancelRevenue(ctx, types.NewMsgCancelRevenue(contract.Hex(), deployer))
+	suite.Require().NoError(err)
+	suite.Require().False(k.IsRevenueRegistered(suite.ctx, contract))
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	k := suite.app.RevenueKeeper
+	params := types.NewParams(true, sdk.NewDecWithPrec(3, 1), 10)
+
+	_, err := k.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{
+		Authority: suite.withdrawer.String(),
+		Params:    params,
+	})
+	suite.Require().Error(err)
+
+	_, err = k.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Params:    params,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, k.GetParams(suite.ctx))
+}
@@ -0,0 +1,115 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/revenue/types"
+)
+
+// GetRevenue returns the registration of a contract
+func (k Keeper) GetRevenue(ctx sdk.Context, contract common.Address) (types.Revenue, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RevenueKey(contract))
+	if len(bz) == 0 {
+		return types.Revenue{}, false
+	}
+
+	var revenue types.Revenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue, true
+}
+
+// IsRevenueRegistered returns true if the contract is registered
+func (k Keeper) IsRevenueRegistered(ctx sdk.Context, contract common.Address) bool {
+	return ctx.KVStore(k.storeKey).Has(types.RevenueKey(contract))
+}
+
+// SetRevenue stores the registration of a contract and indexes it by deployer and withdrawer
+func (k Keeper) SetRevenue(ctx sdk.Context, revenue types.Revenue) {
+	store := ctx.KVStore(k.storeKey)
+	contract := revenue.GetContractAddr()
+
+	// drop the index of the previous withdrawer on update
+	if previous, found := k.GetRevenue(ctx, contract); found {
+		store.Delete(types.WithdrawerRevenueKey(previous.GetWithdrawerAddr(), contract))
+	}
+
+	store.Set(types.RevenueKey(contract), k.cdc.MustMarshal(&revenue))
+	store.Set(types.DeployerRevenueKey(revenue.GetDeployerAddr(), contract), []byte{1})
+	store.Set(types.WithdrawerRevenueKey(revenue.GetWithdrawerAddr(), contract), []byte{1})
+}
+
+// DeleteRevenue removes the registration of a contract and its indexes
+func (k Keeper) DeleteRevenue(ctx sdk.Context, revenue types.Revenue) {
+	store := ctx.KVStore(k.storeKey)
+	contract := revenue.GetContractAddr()
+
+	store.Delete(types.RevenueKey(contract))
+	store.Delete(types.DeployerRevenueKey(revenue.GetDeployerAddr(), contract))
+	store.Delete(types.WithdrawerRevenueKey(revenue.GetWithdrawerAddr(), contract))
+}
+
+// IterateRevenues iterates over all the registered contracts, the iteration stops when the callback
+// returns true
+func (k Keeper) IterateRevenues(ctx sdk.Context, cb func(revenue types.Revenue) (stop bool)) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revenue types.Revenue
+		k.cdc.MustUnmarshal(iterator.Value(), &revenue)
+
+		if cb(revenue) {
+			break
+		}
+	}
+}
+
+// GetRevenues returns all the registered contracts
+func (k Keeper) GetRevenues(ctx sdk.Context) []types.Revenue {
+	revenues := []types.Revenue{}
+	k.IterateRevenues(ctx, func(revenue types.Revenue) bool {
+		revenues = append(revenues, revenue)
+		return false
+	})
+	return revenues
+}
+
+// GetDeployerRevenues returns the contracts registered by a deployer
+func (k Keeper) GetDeployerRevenues(ctx sdk.Context, deployer sdk.AccAddress) []common.Address {
+	return k.getIndexedContracts(ctx, types.DeployerRevenuesPrefix(deployer))
+}
+
+// GetWithdrawerRevenues returns the contracts whose fees are withdrawn by an account
+func (k Keeper) GetWithdrawerRevenues(ctx sdk.Context, withdrawer sdk.AccAddress) []common.Address {
+	return k.getIndexedContracts(ctx, types.WithdrawerRevenuesPrefix(withdrawer))
+}
+
+func (k Keeper) getIndexedContracts(ctx sdk.Context, indexPrefix []byte) []common.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	contracts := []common.Address{}
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, common.BytesToAddress(iterator.Key()))
+	}
+	return contracts
+}
@@ -0,0 +1,53 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	codeErrRevenueDisabled = uint32(iota) + 2 // NOTE: code 1 is reserved for internal errors
+	codeErrRevenueAlreadyRegistered
+	codeErrRevenueNotFound
+	codeErrRevenueNoContractDeployed
+	codeErrRevenueDeployerIsNotEOA
+	codeErrRevenueInvalidProof
+	codeErrRevenueInvalidWithdrawer
+)
+
+var (
+	// ErrRevenueDisabled returns an error if the revenue module is disabled
+	ErrRevenueDisabled = errorsmod.Register(ModuleName, codeErrRevenueDisabled, "revenue module is disabled by governance")
+
+	// ErrRevenueAlreadyRegistered returns an error if the contract is already registered
+	ErrRevenueAlreadyRegistered = errorsmod.Register(ModuleName, codeErrRevenueAlreadyRegistered, "contract is already registered")
+
+	// ErrRevenueNotFound returns an error if the contract is not registered
+	ErrRevenueNotFound = errorsmod.Register(ModuleName, codeErrRevenueNotFound, "contract is not registered")
+
+	// ErrRevenueNoContractDeployed returns an error if there is no contract at the address
+	ErrRevenueNoContractDeployed = errorsmod.Register(ModuleName, codeErrRevenueNoContractDeployed, "no contract deployed")
+
+	// ErrRevenueDeployerIsNotEOA returns an error if the deployer is a contract
+	ErrRevenueDeployerIsNotEOA = errorsmod.Register(ModuleName, codeErrRevenueDeployerIsNotEOA, "deployer is not an EOA")
+
+	// ErrRevenueInvalidProof returns an error if the deployer nonces don't derive the contract address
+	ErrRevenueInvalidProof = errorsmod.Register(ModuleName, codeErrRevenueInvalidProof, "invalid deployer nonces proof of ownership")
+
+	// ErrRevenueInvalidWithdrawer returns an error if the withdrawer can't receive the fees
+	ErrRevenueInvalidWithdrawer = errorsmod.Register(ModuleName, codeErrRevenueInvalidWithdrawer, "invalid withdrawer address")
+)
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	contract := tests.GenerateAddress()
+	deployer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	withdrawer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		genState *GenesisState
+		expPass  bool
+	}{
+		{
+			"default",
+			DefaultGenesisState(),
+			true,
+		},
+		{
+			"valid genesis",
+			NewGenesisState(DefaultParams(), []Revenue{
+				NewRevenue(contract, deployer, nil),
+				NewRevenue(tests.GenerateAddress(), deployer, withdrawer),
+			}),
+			true,
+		},
+		{
+			"invalid genesis - duplicated contract",
+			NewGenesisState(DefaultParams(), []Revenue{
+				NewRevenue(contract, deployer, nil),
+				NewRevenue(contract, deployer, withdrawer),
+			}),
+			false,
+		},
+		{
+			"invalid genesis - invalid deployer",
+			NewGenesisState(DefaultParams(), []Revenue{
+				{ContractAddress: contract.Hex(), DeployerAddress: "invalid"},
+			}),
+			false,
+		},
+		{
+			"invalid genesis - invalid params",
+			NewGenesisState(NewParams(true, sdk.NewDec(-1), 0), []Revenue{}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
+
+func (suite *GenesisTestSuite) TestRevenueWithdrawer() {
+	contract := tests.GenerateAddress()
+	deployer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+	withdrawer := sdk.AccAddress(tests.GenerateAddress().Bytes())
+
+	revenue := NewRevenue(contract, deployer, deployer)
+	suite.Require().Empty(revenue.WithdrawerAddress)
+	suite.Require().Equal(deployer, revenue.GetWithdrawerAddr())
+
+	revenue = NewRevenue(contract, deployer, withdrawer)
+	suite.Require().Equal(withdrawer, revenue.GetWithdrawerAddr())
+	suite.Require().Equal(contract, revenue.GetContractAddr())
+}
@@ -0,0 +1,74 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName string name of module
+	ModuleName = "revenue"
+
+	// StoreKey key for the registered contracts and the params.
+	StoreKey = ModuleName
+
+	// RouterKey uses module name for routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the revenue persistent store
+const (
+	prefixRevenue = iota + 1
+	prefixDeployer
+	prefixWithdrawer
+	prefixParams
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRevenue    = []byte{prefixRevenue}
+	KeyPrefixDeployer   = []byte{prefixDeployer}
+	KeyPrefixWithdrawer = []byte{prefixWithdrawer}
+	KeyPrefixParams     = []byte{prefixParams}
+)
+
+// RevenueKey returns the key of the registration of a contract
+func RevenueKey(contract common.Address) []byte {
+	return append(KeyPrefixRevenue, contract.Bytes()...)
+}
+
+// DeployerRevenuesPrefix returns the prefix of the contracts registered by a deployer
+func DeployerRevenuesPrefix(deployer sdk.AccAddress) []byte {
+	return append(KeyPrefixDeployer, address.MustLengthPrefix(deployer)...)
+}
+
+// DeployerRevenueKey returns the key indexing a contract registered by a deployer
+func DeployerRevenueKey(deployer sdk.AccAddress, contract common.Address) []byte {
+	return append(DeployerRevenuesPrefix(deployer), contract.Bytes()...)
+}
+
+// WithdrawerRevenuesPrefix returns the prefix of the contracts whose fees are withdrawn by an account
+func WithdrawerRevenuesPrefix(withdrawer sdk.AccAddress) []byte {
+	return append(KeyPrefixWithdrawer, address.MustLengthPrefix(withdrawer)...)
+}
+
+// WithdrawerRevenueKey returns the key indexing a contract whose fees are withdrawn by an account
+func WithdrawerRevenueKey(withdrawer sdk.AccAddress, contract common.Address) []byte {
+	return append(WithdrawerRevenuesPrefix(withdrawer), contract.Bytes()...)
+}
@@ -0,0 +1,161 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ethermint "github.com/evmos/ethermint/types"
+)
+
+// MaxNonces is the maximum number of nonces of a proof of ownership, i.e. the maximum depth of
+// the factory contracts chain deploying a registered contract
+const MaxNonces = 20
+
+var (
+	_ sdk.Msg = &MsgRegisterRevenue{}
+	_ sdk.Msg = &MsgUpdateRevenue{}
+	_ sdk.Msg = &MsgCancelRevenue{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgRegisterRevenue creates a new MsgRegisterRevenue instance
+func NewMsgRegisterRevenue(contract string, deployer, withdrawer sdk.AccAddress, nonces []uint64) *MsgRegisterRevenue {
+	withdrawerAddr := ""
+	if len(withdrawer) > 0 {
+		withdrawerAddr = withdrawer.String()
+	}
+
+	return &MsgRegisterRevenue{
+		ContractAddress:   contract,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawerAddr,
+		Nonces:            nonces,
+	}
+}
+
+// GetSigners returns the expected signers for a MsgRegisterRevenue message.
+func (m *MsgRegisterRevenue) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.DeployerAddress)}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterRevenue) ValidateBasic() error {
+	revenue := Revenue{
+		ContractAddress:   m.ContractAddress,
+		DeployerAddress:   m.DeployerAddress,
+		WithdrawerAddress: m.WithdrawerAddress,
+	}
+	if err := revenue.Validate(); err != nil {
+		return err
+	}
+
+	if len(m.Nonces) == 0 || len(m.Nonces) > MaxNonces {
+		return errorsmod.Wrapf(ErrRevenueInvalidProof, "expected 1 to %d nonces, got %d", MaxNonces, len(m.Nonces))
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgUpdateRevenue creates a new MsgUpdateRevenue instance
+func NewMsgUpdateRevenue(contract string, deployer, withdrawer sdk.AccAddress) *MsgUpdateRevenue {
+	return &MsgUpdateRevenue{
+		ContractAddress:   contract,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// GetSigners returns the expected signers for a MsgUpdateRevenue message.
+func (m *MsgUpdateRevenue) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.DeployerAddress)}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateRevenue) ValidateBasic() error {
+	if m.WithdrawerAddress == "" {
+		return errorsmod.Wrap(ErrRevenueInvalidWithdrawer, "withdrawer address cannot be empty")
+	}
+
+	revenue := Revenue{
+		ContractAddress:   m.ContractAddress,
+		DeployerAddress:   m.DeployerAddress,
+		WithdrawerAddress: m.WithdrawerAddress,
+	}
+	return revenue.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// NewMsgCancelRevenue creates a new MsgCancelRevenue instance
+func NewMsgCancelRevenue(contract string, deployer sdk.AccAddress) *MsgCancelRevenue {
+	return &MsgCancelRevenue{
+		ContractAddress: contract,
+		DeployerAddress: deployer.String(),
+	}
+}
+
+// GetSigners returns the expected signers for a MsgCancelRevenue message.
+func (m *MsgCancelRevenue) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.DeployerAddress)}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgCancelRevenue) ValidateBasic() error {
+	if err := ethermint.ValidateNonZeroAddress(m.ContractAddress); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.DeployerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCancelRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,209 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/evmos/ethermint/tests"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+
+	contract   string
+	deployer   sdk.AccAddress
+	withdrawer sdk.AccAddress
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) SetupTest() {
+	suite.contract = tests.GenerateAddress().Hex()
+	suite.deployer = sdk.AccAddress(tests.GenerateAddress().Bytes())
+	suite.withdrawer = sdk.AccAddress(tests.GenerateAddress().Bytes())
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterRevenueValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgRegisterRevenue
+		expPass bool
+	}{
+		{
+			"pass - without withdrawer",
+			NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, []uint64{1}),
+			true,
+		},
+		{
+			"pass - with withdrawer",
+			NewMsgRegisterRevenue(suite.contract, suite.deployer, suite.withdrawer, []uint64{1, 2}),
+			true,
+		},
+		{
+			"fail - invalid contract address",
+			NewMsgRegisterRevenue("0x123", suite.deployer, nil, []uint64{1}),
+			false,
+		},
+		{
+			"fail - zero contract address",
+			NewMsgRegisterRevenue("0x0000000000000000000000000000000000000000", suite.deployer, nil, []uint64{1}),
+			false,
+		},
+		{
+			"fail - invalid deployer address",
+			&MsgRegisterRevenue{ContractAddress: suite.contract, DeployerAddress: "invalid", Nonces: []uint64{1}},
+			false,
+		},
+		{
+			"fail - invalid withdrawer address",
+			&MsgRegisterRevenue{
+				ContractAddress:   suite.contract,
+				DeployerAddress:   suite.deployer.String(),
+				WithdrawerAddress: "invalid",
+				Nonces:            []uint64{1},
+			},
+			false,
+		},
+		{
+			"fail - no nonces",
+			NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, nil),
+			false,
+		},
+		{
+			"fail - too many nonces",
+			NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, make([]uint64, MaxNonces+1)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateRevenueValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateRevenue
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgUpdateRevenue(suite.contract, suite.deployer, suite.withdrawer),
+			true,
+		},
+		{
+			"fail - empty withdrawer",
+			&MsgUpdateRevenue{ContractAddress: suite.contract, DeployerAddress: suite.deployer.String()},
+			false,
+		},
+		{
+			"fail - invalid contract address",
+			NewMsgUpdateRevenue("invalid", suite.deployer, suite.withdrawer),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgCancelRevenueValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgCancelRevenue
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgCancelRevenue(suite.contract, suite.deployer),
+			true,
+		},
+		{
+			"fail - invalid contract address",
+			NewMsgCancelRevenue("invalid", suite.deployer),
+			false,
+		},
+		{
+			"fail - invalid deployer address",
+			&MsgCancelRevenue{ContractAddress: suite.contract, DeployerAddress: "invalid"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateParamsValidateBasic() {
+	testCases := []struct {
+		name      string
+		msgUpdate *MsgUpdateParams
+		expPass   bool
+	}{
+		{
+			"fail - invalid authority address",
+			&MsgUpdateParams{
+				Authority: "invalid",
+				Params:    DefaultParams(),
+			},
+			false,
+		},
+		{
+			"fail - invalid params",
+			&MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    NewParams(true, sdk.NewDec(2), DefaultAddrDerivationCostCreate),
+			},
+			false,
+		},
+		{
+			"pass - valid msg",
+			&MsgUpdateParams{
+				Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				Params:    DefaultParams(),
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msgUpdate.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
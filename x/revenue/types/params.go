@@ -0,0 +1,66 @@
+// Copyright 2021 Evmos Foundation
+// This file is part of Evmos' Ethermint library.
+//
+// The Ethermint library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The Ethermint library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the Ethermint library. If not, see https://github.com/evmos/ethermint/blob/main/LICENSE
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// DefaultEnableRevenue is true
+	DefaultEnableRevenue = true
+	// DefaultDeveloperShares is 0.5 or 50%
+	DefaultDeveloperShares = sdk.NewDecWithPrec(50, 2)
+	// DefaultAddrDerivationCostCreate is the gas cost of a contract address derivation
+	DefaultAddrDerivationCostCreate = uint64(50)
+)
+
+// NewParams creates a new Params instance
+func NewParams(enableRevenue bool, developerShares sdk.Dec, addrDerivationCostCreate uint64) Params {
+	return Params{
+		EnableRevenue:            enableRevenue,
+		DeveloperShares:          developerShares,
+		AddrDerivationCostCreate: addrDerivationCostCreate,
+	}
+}
+
+// DefaultParams returns default revenue parameters
+func DefaultParams() Params {
+	return NewParams(DefaultEnableRevenue, DefaultDeveloperShares, DefaultAddrDerivationCostCreate)
+}
+
+// Validate performs basic validation on revenue parameters.
+func (p Params) Validate() error {
+	return validateShares(p.DeveloperShares)
+}
+
+func validateShares(shares sdk.Dec) error {
+	if shares.IsNil() {
+		return fmt.Errorf("invalid parameter: nil")
+	}
+
+	if shares.IsNegative() {
+		return fmt.Errorf("developer shares cannot be negative: %s", shares)
+	}
+
+	if shares.GT(sdk.OneDec()) {
+		return fmt.Errorf("developer shares cannot be greater than 1: %s", shares)
+	}
+
+	return nil
+}
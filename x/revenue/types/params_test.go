@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+)
+
+type ParamsTestSuite struct {
+	suite.Suite
+}
+
+func TestParamsTestSuite(t *testing.T) {
+	suite.Run(t, new(ParamsTestSuite))
+}
+
+func (suite *ParamsTestSuite) TestParamsValidate() {
+	testCases := []struct {
+		name     string
+		params   Params
+		expError bool
+	}{
+		{"default", DefaultParams(), false},
+		{"valid", NewParams(true, sdk.NewDecWithPrec(25, 2), 100), false},
+		{"valid: zero developer shares", NewParams(true, sdk.ZeroDec(), 0), false},
+		{"valid: all the fees to the developer", NewParams(false, sdk.OneDec(), 0), false},
+		{"empty", Params{}, true},
+		{"invalid: negative developer shares", NewParams(true, sdk.NewDecWithPrec(-1, 2), 0), true},
+		{"invalid: developer shares bigger than 1", NewParams(true, sdk.NewDecWithPrec(101, 2), 0), true},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}